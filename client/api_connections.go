@@ -0,0 +1,39 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzureApiConnections https://learn.microsoft.com/en-us/azure/templates/microsoft.web/connections?pivots=deployment-language-arm-template
+func (s *azureClient) ListAzureApiConnections(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.ApiConnection] {
+	var (
+		out    = make(chan AzureResult[azure.ApiConnection])
+		path   = fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Web/connections", subscriptionId)
+		params = query.RMParams{ApiVersion: "2016-06-01"}
+	)
+
+	go getAzureObjectList[azure.ApiConnection](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
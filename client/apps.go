@@ -48,14 +48,14 @@ func (s *azureClient) ListAzureADAppOwners(ctx context.Context, objectId string,
 
 	var (
 		out  = make(chan AzureResult[json.RawMessage])
-		path = fmt.Sprintf("/%s/applications/%s/owners", constants.GraphApiBetaVersion, objectId)
+		path = fmt.Sprintf("/applications/%s/owners", objectId)
 	)
 
 	if params.Top == 0 {
 		params.Top = 99
 	}
 
-	go getAzureObjectList[json.RawMessage](s.msgraph, ctx, path, params, out)
+	go getGraphObjectList[json.RawMessage](s, ctx, constants.GraphApiBetaVersion, path, params, out)
 
 	return out
 }
@@ -74,3 +74,15 @@ func (s *azureClient) ListAzureADAppFICs(ctx context.Context, objectId string, p
 
 	return out
 }
+
+// ListAzureADAppsDelta https://learn.microsoft.com/en-us/graph/api/application-delta?view=graph-rest-1.0
+func (s *azureClient) ListAzureADAppsDelta(ctx context.Context, deltaLink string, params query.GraphParams) <-chan AzureResult[azure.DeltaItem[azure.Application]] {
+	var (
+		out  = make(chan AzureResult[azure.DeltaItem[azure.Application]])
+		path = fmt.Sprintf("/%s/applications/delta", constants.GraphApiVersion)
+	)
+
+	go getAzureDeltaList[azure.Application](s.msgraph, ctx, path, deltaLink, params, out)
+
+	return out
+}
@@ -37,3 +37,59 @@ func (s *azureClient) ListAzureAutomationAccounts(ctx context.Context, subscript
 
 	return out
 }
+
+// ==
+// Automation account assets
+// ==
+
+// ListAzureAutomationRunbooks https://learn.microsoft.com/en-us/rest/api/automation/runbook/list-by-automation-account?view=rest-automation-2023-11-01
+func (s *azureClient) ListAzureAutomationRunbooks(ctx context.Context, automationAccountId string) <-chan AzureResult[azure.AutomationRunbook] {
+	var (
+		out    = make(chan AzureResult[azure.AutomationRunbook])
+		path   = fmt.Sprintf("%s/runbooks", automationAccountId)
+		params = query.RMParams{ApiVersion: "2023-11-01"}
+	)
+
+	go getAzureObjectList[azure.AutomationRunbook](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureAutomationCredentials https://learn.microsoft.com/en-us/rest/api/automation/credential/list-by-automation-account?view=rest-automation-2023-11-01
+func (s *azureClient) ListAzureAutomationCredentials(ctx context.Context, automationAccountId string) <-chan AzureResult[azure.AutomationCredential] {
+	var (
+		out    = make(chan AzureResult[azure.AutomationCredential])
+		path   = fmt.Sprintf("%s/credentials", automationAccountId)
+		params = query.RMParams{ApiVersion: "2023-11-01"}
+	)
+
+	go getAzureObjectList[azure.AutomationCredential](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureAutomationVariables https://learn.microsoft.com/en-us/rest/api/automation/variable/list-by-automation-account?view=rest-automation-2023-11-01
+func (s *azureClient) ListAzureAutomationVariables(ctx context.Context, automationAccountId string) <-chan AzureResult[azure.AutomationVariable] {
+	var (
+		out    = make(chan AzureResult[azure.AutomationVariable])
+		path   = fmt.Sprintf("%s/variables", automationAccountId)
+		params = query.RMParams{ApiVersion: "2023-11-01"}
+	)
+
+	go getAzureObjectList[azure.AutomationVariable](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureAutomationHybridWorkerGroups https://learn.microsoft.com/en-us/rest/api/automation/hybrid-runbook-worker-group/list-by-automation-account?view=rest-automation-2023-11-01
+func (s *azureClient) ListAzureAutomationHybridWorkerGroups(ctx context.Context, automationAccountId string) <-chan AzureResult[azure.AutomationHybridWorkerGroup] {
+	var (
+		out    = make(chan AzureResult[azure.AutomationHybridWorkerGroup])
+		path   = fmt.Sprintf("%s/hybridRunbookWorkerGroups", automationAccountId)
+		params = query.RMParams{ApiVersion: "2023-11-01"}
+	)
+
+	go getAzureObjectList[azure.AutomationHybridWorkerGroup](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
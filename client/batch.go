@@ -0,0 +1,338 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client/config"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/client/rest"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+)
+
+const (
+	// Microsoft Graph accepts at most 20 requests per $batch call.
+	graphBatchSize = 20
+
+	// How long a partially filled batch waits for more requests before it is sent.
+	graphBatchLinger = 50 * time.Millisecond
+)
+
+// graphBatcher combines concurrent per-object Microsoft Graph requests into JSON $batch calls.
+// https://learn.microsoft.com/en-us/graph/json-batching
+//
+// Each request in a batch succeeds or fails on its own; throttled (429) and failed (5xx) requests are retried
+// individually in a later batch, so one bad item never fails or delays the rest of its batch.
+type graphBatcher struct {
+	client  rest.RestClient
+	version string
+	host    string
+	retry   rest.RetryPolicy
+	mutex   sync.Mutex
+	pending []*graphBatchItem
+	timer   *time.Timer
+}
+
+type graphBatchRequest struct {
+	Id      string            `json:"id"`
+	Method  string            `json:"method"`
+	Url     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+type graphBatchResponse struct {
+	Id      string            `json:"id"`
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+type graphBatchItem struct {
+	ctx     context.Context
+	request graphBatchRequest
+	attempt int
+	result  chan AzureResult[json.RawMessage]
+}
+
+func newGraphBatcher(client rest.RestClient, version string, config config.Config) *graphBatcher {
+	batcher := &graphBatcher{
+		client:  client,
+		version: version,
+		retry:   rest.NewRetryPolicy(config),
+	}
+	if graphUrl, err := url.Parse(config.GraphUrl()); err == nil {
+		batcher.host = graphUrl.Host
+	}
+	return batcher
+}
+
+// Get queues a GET request for a url relative to the batcher's API version, e.g. "/groups/{id}/members", and returns
+// the response body once its batch has been sent.
+func (s *graphBatcher) Get(ctx context.Context, url string, headers map[string]string) (json.RawMessage, error) {
+	item := &graphBatchItem{
+		ctx:     ctx,
+		request: graphBatchRequest{Method: http.MethodGet, Url: url, Headers: headers},
+		result:  make(chan AzureResult[json.RawMessage], 1),
+	}
+	s.enqueue(item)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-item.result:
+		return result.Ok, result.Error
+	}
+}
+
+func (s *graphBatcher) enqueue(item *graphBatchItem) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.pending = append(s.pending, item)
+	if len(s.pending) >= graphBatchSize {
+		go s.send(s.take())
+	} else if len(s.pending) == 1 {
+		s.timer = time.AfterFunc(graphBatchLinger, s.flush)
+	}
+}
+
+func (s *graphBatcher) flush() {
+	s.mutex.Lock()
+	items := s.take()
+	s.mutex.Unlock()
+
+	if len(items) > 0 {
+		s.send(items)
+	}
+}
+
+// take must be called with the mutex held.
+func (s *graphBatcher) take() []*graphBatchItem {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	items := s.pending
+	s.pending = nil
+	return items
+}
+
+func (s *graphBatcher) send(items []*graphBatchItem) {
+	defer panicrecovery.PanicRecovery()
+
+	var (
+		body struct {
+			Requests []graphBatchRequest `json:"requests"`
+		}
+		response struct {
+			Responses []graphBatchResponse `json:"responses"`
+		}
+		path      = fmt.Sprintf("/%s/$batch", s.version)
+		waiting   []*graphBatchItem
+		remaining atomic.Int32
+	)
+
+	// Requests that are no longer waited on are left out of the batch
+	for _, item := range items {
+		if err := item.ctx.Err(); err != nil {
+			item.result <- AzureResult[json.RawMessage]{Error: err}
+		} else {
+			waiting = append(waiting, item)
+		}
+	}
+	if items = waiting; len(items) == 0 {
+		return
+	}
+
+	for i, item := range items {
+		item.request.Id = strconv.Itoa(i)
+		body.Requests = append(body.Requests, item.request)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pageRequestTimeout)
+	defer cancel()
+
+	// The batch is cancelled once every request in it is
+	remaining.Store(int32(len(items)))
+	for _, item := range items {
+		stop := context.AfterFunc(item.ctx, func() {
+			if remaining.Add(-1) == 0 {
+				cancel()
+			}
+		})
+		defer stop()
+	}
+
+	if res, err := s.client.Post(ctx, path, body, nil, nil); err != nil {
+		s.fail(items, err)
+	} else if err := rest.Decode(res.Body, &response); err != nil {
+		s.fail(items, err)
+	} else {
+		responses := make(map[string]graphBatchResponse, len(response.Responses))
+		for _, res := range response.Responses {
+			responses[res.Id] = res
+		}
+		for _, item := range items {
+			if res, ok := responses[item.request.Id]; !ok {
+				item.result <- AzureResult[json.RawMessage]{Error: fmt.Errorf("missing response for batched request to %s", item.request.Url)}
+			} else {
+				s.handle(item, res)
+			}
+		}
+	}
+}
+
+func (s *graphBatcher) fail(items []*graphBatchItem, err error) {
+	for _, item := range items {
+		item.result <- AzureResult[json.RawMessage]{Error: err}
+	}
+}
+
+// handle applies the retry policy and throttling of the REST client to a single response of a batch.
+// See https://learn.microsoft.com/en-us/azure/architecture/best-practices/retry-service-specific#retry-usage-guidance
+func (s *graphBatcher) handle(item *graphBatchItem, res graphBatchResponse) {
+	headers := http.Header{}
+	for key, value := range res.Headers {
+		headers.Set(key, value)
+	}
+
+	if throttled := rest.ObserveThrottling(s.host, res.Status, headers); res.Status >= http.StatusOK && res.Status < http.StatusBadRequest {
+		item.result <- AzureResult[json.RawMessage]{Ok: res.Body}
+	} else if !throttled && !s.retry.RetryableStatus(res.Status) {
+		// Not a status code that warrants a retry
+		var errRes map[string]interface{}
+		if err := json.Unmarshal(res.Body, &errRes); err != nil {
+			item.result <- AzureResult[json.RawMessage]{Error: fmt.Errorf("malformed error response, status code: %d", res.Status)}
+		} else {
+			item.result <- AzureResult[json.RawMessage]{Error: fmt.Errorf("%v", errRes)}
+		}
+	} else if item.attempt++; item.attempt >= s.retry.Attempts {
+		item.result <- AzureResult[json.RawMessage]{Error: fmt.Errorf("unable to complete the request after %d attempts, status code: %d", s.retry.Attempts, res.Status)}
+	} else {
+		go func() {
+			defer panicrecovery.PanicRecovery()
+
+			// A throttled request waits out the pause of the host along with the batch it is queued in, any other one
+			// waits the backoff of the retry policy before it is queued again
+			if throttled {
+				if err := item.ctx.Err(); err != nil {
+					item.result <- AzureResult[json.RawMessage]{Error: err}
+					return
+				}
+			} else if err := s.retry.Wait(item.ctx, item.attempt-1); err != nil {
+				item.result <- AzureResult[json.RawMessage]{Error: err}
+				return
+			}
+			s.enqueue(item)
+		}()
+	}
+}
+
+// getAzureObjectListBatched is the $batch counterpart of getAzureObjectList for Microsoft Graph paths relative to the
+// batcher's API version.
+func getAzureObjectListBatched[T any](batcher *graphBatcher, ctx context.Context, path string, params query.Params, out chan AzureResult[T]) {
+	defer panicrecovery.PanicRecovery()
+	defer close(out)
+
+	var (
+		errResult AzureResult[T]
+		headers   map[string]string
+		nextLink  = path
+	)
+
+	if params != nil {
+		values := url.Values{}
+		for key, value := range params.AsMap() {
+			values.Set(key, value)
+		}
+		if encoded := values.Encode(); encoded != "" {
+			nextLink = path + "?" + encoded
+		}
+		if params.NeedsEventualConsistencyHeaderFlag() {
+			headers = map[string]string{"ConsistencyLevel": "eventual"}
+		}
+	}
+
+	for nextLink != "" {
+		var list struct {
+			NextLinkGraph string `json:"@odata.nextLink,omitempty"`
+			Value         []T    `json:"value"`
+		}
+
+		if body, err := batcher.Get(ctx, nextLink, headers); err != nil {
+			errResult.Error = err
+			_ = pipeline.Send(ctx.Done(), out, errResult)
+			return
+		} else if err := json.Unmarshal(body, &list); err != nil {
+			errResult.Error = err
+			_ = pipeline.Send(ctx.Done(), out, errResult)
+			return
+		}
+
+		for _, u := range list.Value {
+			if ok := pipeline.Send(ctx.Done(), out, AzureResult[T]{Ok: u}); !ok {
+				return
+			}
+		}
+
+		if list.NextLinkGraph == "" {
+			nextLink = ""
+		} else if link, err := batcher.relativeUrl(list.NextLinkGraph); err != nil {
+			errResult.Error = err
+			_ = pipeline.Send(ctx.Done(), out, errResult)
+			return
+		} else {
+			nextLink = link
+		}
+	}
+}
+
+// relativeUrl converts an absolute @odata.nextLink into a url relative to the batcher's API version, as required for
+// requests inside a $batch call.
+func (s *graphBatcher) relativeUrl(link string) (string, error) {
+	if parsed, err := url.Parse(link); err != nil {
+		return "", err
+	} else if path, ok := strings.CutPrefix(parsed.EscapedPath(), "/"+s.version); !ok {
+		return "", fmt.Errorf("next link %s is not for graph api version %s", link, s.version)
+	} else if parsed.RawQuery != "" {
+		return path + "?" + parsed.RawQuery, nil
+	} else {
+		return path, nil
+	}
+}
+
+// getGraphObjectList lists a Microsoft Graph collection at a path relative to the API version, through $batch calls
+// when batching is enabled and with a request of its own per page otherwise.
+func getGraphObjectList[T any](s *azureClient, ctx context.Context, version string, path string, params query.Params, out chan AzureResult[T]) {
+	if batcher, ok := s.graphBatchers[version]; ok {
+		getAzureObjectListBatched[T](batcher, ctx, path, params, out)
+	} else {
+		getAzureObjectList[T](s.msgraph, ctx, "/"+version+path, params, out)
+	}
+}
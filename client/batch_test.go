@@ -0,0 +1,223 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client/config"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/stretchr/testify/require"
+)
+
+type testBatchRequests struct {
+	Requests []graphBatchRequest `json:"requests"`
+}
+
+// batchServer returns a fakeRestClient that answers each $batch call with respond and records every call made.
+func batchServer(t *testing.T, respond func(req graphBatchRequest) graphBatchResponse) (*fakeRestClient, *[]testBatchRequests) {
+	var (
+		mutex sync.Mutex
+		calls []testBatchRequests
+	)
+	return &fakeRestClient{
+		postFunc: func(ctx context.Context, path string, body interface{}, params query.Params, headers map[string]string) (*http.Response, error) {
+			require.Equal(t, "/beta/$batch", path)
+
+			var batch testBatchRequests
+			bytes, err := json.Marshal(body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(bytes, &batch))
+			require.LessOrEqual(t, len(batch.Requests), graphBatchSize)
+
+			mutex.Lock()
+			calls = append(calls, batch)
+			mutex.Unlock()
+
+			var response struct {
+				Responses []graphBatchResponse `json:"responses"`
+			}
+			for _, req := range batch.Requests {
+				res := respond(req)
+				res.Id = req.Id
+				response.Responses = append(response.Responses, res)
+			}
+			bytes, err = json.Marshal(response)
+			require.NoError(t, err)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(string(bytes))),
+			}, nil
+		},
+	}, &calls
+}
+
+func TestGraphBatcher_CombinesRequests(t *testing.T) {
+	client, calls := batchServer(t, func(req graphBatchRequest) graphBatchResponse {
+		return graphBatchResponse{Status: http.StatusOK, Body: json.RawMessage(fmt.Sprintf(`{"url": %q}`, req.Url))}
+	})
+	batcher := newGraphBatcher(client, "beta", config.Config{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 25; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			url := fmt.Sprintf("/groups/%d/members", i)
+			body, err := batcher.Get(context.Background(), url, nil)
+			require.NoError(t, err)
+			require.JSONEq(t, fmt.Sprintf(`{"url": %q}`, url), string(body))
+		}(i)
+	}
+	wg.Wait()
+
+	var total int
+	for _, call := range *calls {
+		total += len(call.Requests)
+	}
+	require.Equal(t, 25, total)
+	require.Less(t, len(*calls), 25)
+}
+
+func TestGraphBatcher_ItemErrors(t *testing.T) {
+	client, _ := batchServer(t, func(req graphBatchRequest) graphBatchResponse {
+		if strings.Contains(req.Url, "missing") {
+			return graphBatchResponse{Status: http.StatusNotFound, Body: json.RawMessage(`{"error": {"code": "Request_ResourceNotFound"}}`)}
+		}
+		return graphBatchResponse{Status: http.StatusOK, Body: json.RawMessage(`{"value": []}`)}
+	})
+	batcher := newGraphBatcher(client, "beta", config.Config{})
+
+	var (
+		wg     sync.WaitGroup
+		errs   = make([]error, 2)
+		bodies = make([]json.RawMessage, 2)
+	)
+	for i, url := range []string{"/groups/missing/owners", "/groups/found/owners"} {
+		wg.Add(1)
+		go func(i int, url string) {
+			defer wg.Done()
+			bodies[i], errs[i] = batcher.Get(context.Background(), url, nil)
+		}(i, url)
+	}
+	wg.Wait()
+
+	require.ErrorContains(t, errs[0], "Request_ResourceNotFound")
+	require.NoError(t, errs[1])
+	require.JSONEq(t, `{"value": []}`, string(bodies[1]))
+}
+
+func TestGraphBatcher_RetriesThrottledItems(t *testing.T) {
+	var (
+		mutex     sync.Mutex
+		throttled bool
+	)
+	client, calls := batchServer(t, func(req graphBatchRequest) graphBatchResponse {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if !throttled {
+			throttled = true
+			return graphBatchResponse{Status: http.StatusTooManyRequests, Headers: map[string]string{"retry-after": "0"}}
+		}
+		return graphBatchResponse{Status: http.StatusOK, Body: json.RawMessage(`{"value": []}`)}
+	})
+	batcher := newGraphBatcher(client, "beta", config.Config{})
+
+	body, err := batcher.Get(context.Background(), "/devices/1/registeredOwners", nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"value": []}`, string(body))
+	require.Len(t, *calls, 2)
+}
+
+func TestGraphBatcher_RetriesThrottledItemsWithoutRetryAfter(t *testing.T) {
+	client, calls := batchServer(t, func(req graphBatchRequest) graphBatchResponse {
+		return graphBatchResponse{Status: http.StatusTooManyRequests}
+	})
+	batcher := newGraphBatcher(client, "beta", config.Config{RetryAttempts: 2})
+
+	_, err := batcher.Get(context.Background(), "/devices/1/registeredOwners", nil)
+	require.ErrorContains(t, err, "after 2 attempts")
+	require.Len(t, *calls, 2)
+}
+
+func TestGetAzureObjectListBatched_FollowsNextLink(t *testing.T) {
+	client, calls := batchServer(t, func(req graphBatchRequest) graphBatchResponse {
+		if strings.Contains(req.Url, "skiptoken") {
+			return graphBatchResponse{Status: http.StatusOK, Body: json.RawMessage(`{"value": [{"id": "2"}]}`)}
+		}
+		return graphBatchResponse{
+			Status: http.StatusOK,
+			Body:   json.RawMessage(`{"value": [{"id": "1"}], "@odata.nextLink": "https://graph.microsoft.com/beta/groups/1/members?$skiptoken=abc"}`),
+		}
+	})
+	batcher := newGraphBatcher(client, "beta", config.Config{})
+
+	out := make(chan AzureResult[map[string]string])
+	go getAzureObjectListBatched(batcher, context.Background(), "/groups/1/members", query.GraphParams{Top: 99}, out)
+
+	var results []map[string]string
+	for result := range out {
+		require.NoError(t, result.Error)
+		results = append(results, result.Ok)
+	}
+
+	require.Len(t, results, 2)
+	require.Equal(t, "1", results[0]["id"])
+	require.Equal(t, "2", results[1]["id"])
+	require.Len(t, *calls, 2)
+	require.Equal(t, "/groups/1/members?%24top=99", (*calls)[0].Requests[0].Url)
+	require.Equal(t, "/groups/1/members?$skiptoken=abc", (*calls)[1].Requests[0].Url)
+}
+
+func TestGraphBatcherRelativeUrl(t *testing.T) {
+	batcher := newGraphBatcher(nil, "v1.0", config.Config{})
+
+	_, err := batcher.relativeUrl("https://graph.microsoft.com/beta/groups/1/members")
+	require.Error(t, err)
+}
+
+func TestGraphBatcher_SkipsCancelledItems(t *testing.T) {
+	client, calls := batchServer(t, func(req graphBatchRequest) graphBatchResponse {
+		return graphBatchResponse{Status: http.StatusOK, Body: json.RawMessage(`{"value": []}`)}
+	})
+	batcher := newGraphBatcher(client, "beta", config.Config{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := batcher.Get(ctx, "/devices/1/registeredOwners", nil)
+	require.ErrorIs(t, err, context.Canceled)
+
+	body, err := batcher.Get(context.Background(), "/devices/2/registeredOwners", nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"value": []}`, string(body))
+
+	// The cancelled request was left out of the batch sent along with the other one
+	for _, call := range *calls {
+		for _, req := range call.Requests {
+			require.Equal(t, "/devices/2/registeredOwners", req.Url)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzureBillingAccounts https://learn.microsoft.com/en-us/rest/api/billing/2020-05-01/billing-accounts/list
+func (s *azureClient) ListAzureBillingAccounts(ctx context.Context) <-chan AzureResult[azure.BillingAccount] {
+	var (
+		out    = make(chan AzureResult[azure.BillingAccount])
+		path   = "/providers/Microsoft.Billing/billingAccounts"
+		params = query.RMParams{ApiVersion: "2020-05-01"}
+	)
+
+	go getAzureObjectList[azure.BillingAccount](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureBillingProfiles https://learn.microsoft.com/en-us/rest/api/billing/2020-05-01/billing-profiles/list-by-billing-account
+func (s *azureClient) ListAzureBillingProfiles(ctx context.Context, billingAccountId string) <-chan AzureResult[azure.BillingAccountProfile] {
+	var (
+		out    = make(chan AzureResult[azure.BillingAccountProfile])
+		path   = fmt.Sprintf("%s/billingProfiles", billingAccountId)
+		params = query.RMParams{ApiVersion: "2020-05-01"}
+	)
+
+	go getAzureObjectList[azure.BillingAccountProfile](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureInvoiceSections https://learn.microsoft.com/en-us/rest/api/billing/2020-05-01/invoice-sections/list-by-billing-profile
+func (s *azureClient) ListAzureInvoiceSections(ctx context.Context, billingProfileId string) <-chan AzureResult[azure.InvoiceSection] {
+	var (
+		out    = make(chan AzureResult[azure.InvoiceSection])
+		path   = fmt.Sprintf("%s/invoiceSections", billingProfileId)
+		params = query.RMParams{ApiVersion: "2020-05-01"}
+	)
+
+	go getAzureObjectList[azure.InvoiceSection](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureBillingRoleAssignments https://learn.microsoft.com/en-us/rest/api/billing/2020-05-01/billing-role-assignments
+//
+// The scope may be a billing account, billing profile or invoice section ID.
+func (s *azureClient) ListAzureBillingRoleAssignments(ctx context.Context, scope string) <-chan AzureResult[azure.BillingRoleAssignment] {
+	var (
+		out    = make(chan AzureResult[azure.BillingRoleAssignment])
+		path   = fmt.Sprintf("%s/billingRoleAssignments", scope)
+		params = query.RMParams{ApiVersion: "2020-05-01"}
+	)
+
+	go getAzureObjectList[azure.BillingRoleAssignment](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
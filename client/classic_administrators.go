@@ -0,0 +1,39 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzureClassicAdministrators https://learn.microsoft.com/en-us/rest/api/authorization/classic-administrators/list?view=rest-authorization-2015-07-01
+func (s *azureClient) ListAzureClassicAdministrators(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.ClassicAdministrator] {
+	var (
+		out    = make(chan AzureResult[azure.ClassicAdministrator])
+		path   = fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Authorization/classicAdministrators", subscriptionId)
+		params = query.RMParams{ApiVersion: "2015-07-01"}
+	)
+
+	go getAzureObjectList[azure.ClassicAdministrator](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
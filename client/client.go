@@ -30,6 +30,7 @@ import (
 	"github.com/bloodhoundad/azurehound/v2/client/config"
 	"github.com/bloodhoundad/azurehound/v2/client/query"
 	"github.com/bloodhoundad/azurehound/v2/client/rest"
+	"github.com/bloodhoundad/azurehound/v2/constants"
 	"github.com/bloodhoundad/azurehound/v2/models/azure"
 	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
 	"github.com/bloodhoundad/azurehound/v2/pipeline"
@@ -43,32 +44,72 @@ func NewClient(config config.Config) (AzureClient, error) {
 		return nil, err
 	} else if resourceManager, err := rest.NewRestClient(config.ResourceManagerUrl(), config); err != nil {
 		return nil, err
+	} else if keyVault, err := rest.NewRestClient(config.KeyVaultUrl(), config); err != nil {
+		return nil, err
+	} else if kubernetes, err := rest.NewRestClient(constants.AKSServerAppID, config); err != nil {
+		return nil, err
+	} else if config.KeyVaultDataPlane && !canAuthenticate(config, config.KeyVaultUrl()) {
+		return nil, fmt.Errorf("key vault data plane collection requires a JWT for %s or a credential that tokens can be requested with", config.KeyVaultUrl())
 	} else {
-		if config.JWT != "" {
+		client := &azureClient{
+			msgraph:         msgraph,
+			resourceManager: resourceManager,
+			keyVault:        keyVault,
+			kubernetes:      kubernetes,
+			proxyUrl:        config.ProxyUrl,
+		}
+
+		if config.GraphBatch {
+			client.graphBatchers = map[string]*graphBatcher{
+				constants.GraphApiVersion:     newGraphBatcher(msgraph, constants.GraphApiVersion, config),
+				constants.GraphApiBetaVersion: newGraphBatcher(msgraph, constants.GraphApiBetaVersion, config),
+			}
+		}
+
+		if config.SubRoleAssignments {
+			client.roleAssignments = newRoleAssignmentIndex(resourceManager)
+		}
+
+		if config.GraphJWT != "" {
+			return initClientViaGraph(client)
+		} else if config.MgmtJWT != "" {
+			if body, err := rest.ParseBody(config.MgmtJWT); err != nil {
+				return nil, err
+			} else {
+				return initClientViaRM(client, body["tid"])
+			}
+		} else if config.JWT != "" {
 			if aud, err := rest.ParseAud(config.JWT); err != nil {
 				return nil, err
 			} else if aud == config.GraphUrl() {
-				return initClientViaGraph(msgraph, resourceManager)
+				return initClientViaGraph(client)
 			} else if aud == config.ResourceManagerUrl() {
 				if body, err := rest.ParseBody(config.JWT); err != nil {
 					return nil, err
 				} else {
-					return initClientViaRM(msgraph, resourceManager, body["tid"])
+					return initClientViaRM(client, body["tid"])
 				}
 			} else {
 				return nil, fmt.Errorf("error: invalid token audience")
 			}
 		} else {
-			return initClientViaGraph(msgraph, resourceManager)
+			return initClientViaGraph(client)
 		}
 	}
 }
 
-func initClientViaRM(msgraph, resourceManager rest.RestClient, tid interface{}) (AzureClient, error) {
-	client := &azureClient{
-		msgraph:         msgraph,
-		resourceManager: resourceManager,
+// canAuthenticate reports whether requests to the API at the given url can be authenticated, either with the JWT given
+// for it or, when there is none, with a credential that tokens can be requested with.
+func canAuthenticate(config config.Config, apiUrl string) bool {
+	if jwt := config.JWTFor(apiUrl); jwt != "" {
+		aud, err := rest.ParseAud(jwt)
+		return err == nil && aud == apiUrl
+	} else {
+		return config.CanRequestTokens()
 	}
+}
+
+func initClientViaRM(client *azureClient, tid interface{}) (AzureClient, error) {
 	if result, err := client.GetAzureADTenants(context.Background(), true); err != nil {
 		return nil, err
 	} else {
@@ -82,11 +123,7 @@ func initClientViaRM(msgraph, resourceManager rest.RestClient, tid interface{})
 	}
 }
 
-func initClientViaGraph(msgraph, resourceManager rest.RestClient) (AzureClient, error) {
-	client := &azureClient{
-		msgraph:         msgraph,
-		resourceManager: resourceManager,
-	}
+func initClientViaGraph(client *azureClient) (AzureClient, error) {
 	if org, err := client.GetAzureADOrganization(context.Background(), nil); err != nil {
 		return nil, err
 	} else {
@@ -109,6 +146,11 @@ func getAzureObjectList[T any](client rest.RestClient, ctx context.Context, path
 		nextLink  string
 	)
 
+	// Data plane APIs (e.g. Key Vault) are addressed by an absolute url rather than a path relative to the client's api
+	if endpoint, err := url.Parse(path); err == nil && endpoint.IsAbs() {
+		nextLink = path
+	}
+
 	for {
 		var (
 			list struct {
@@ -187,23 +229,38 @@ func getAzureObjectList[T any](client rest.RestClient, ctx context.Context, path
 type azureClient struct {
 	msgraph         rest.RestClient
 	resourceManager rest.RestClient
+	keyVault        rest.RestClient
+	kubernetes      rest.RestClient // Authenticates requests to AKS API servers with Entra tokens
 	tenant          azure.Tenant
+
+	// Keyed by Microsoft Graph API version; nil unless $batch support is enabled.
+	graphBatchers map[string]*graphBatcher
+
+	// nil unless role assignments are listed once per subscription.
+	roleAssignments *roleAssignmentIndex
+
+	// The forward proxy, if any, that requests to Kubernetes API servers are sent through.
+	proxyUrl string
 }
 
 type AzureGraphClient interface {
 	GetAzureADOrganization(ctx context.Context, selectCols []string) (*azure.Organization, error)
 
 	ListAzureADGroups(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.Group]
+	ListAzureADGroupsDelta(ctx context.Context, deltaLink string, params query.GraphParams) <-chan AzureResult[azure.DeltaItem[azure.Group]]
 	ListAzureADGroupMembers(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
 	ListAzureADGroupOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
 	ListAzureADAppOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
 	ListAzureADAppFICs(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
 	ListAzureADApps(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.Application]
+	ListAzureADAppsDelta(ctx context.Context, deltaLink string, params query.GraphParams) <-chan AzureResult[azure.DeltaItem[azure.Application]]
 	ListAzureADUsers(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.User]
+	ListAzureADUsersDelta(ctx context.Context, deltaLink string, params query.GraphParams) <-chan AzureResult[azure.DeltaItem[azure.User]]
 	ListAzureADRoleAssignments(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.UnifiedRoleAssignment]
 	ListAzureADRoles(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.Role]
 	ListAzureADServicePrincipalOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
 	ListAzureADServicePrincipals(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.ServicePrincipal]
+	ListAzureADServicePrincipalsDelta(ctx context.Context, deltaLink string, params query.GraphParams) <-chan AzureResult[azure.DeltaItem[azure.ServicePrincipal]]
 	ListAzureDeviceRegisteredOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
 	ListAzureDevices(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.Device]
 	ListAzureADAppRoleAssignments(ctx context.Context, servicePrincipalId string, params query.GraphParams) <-chan AzureResult[azure.AppRoleAssignment]
@@ -215,19 +272,60 @@ type AzureResourceManagerClient interface {
 	ListRoleAssignmentsForResource(ctx context.Context, resourceId string, filter, tenantId string) <-chan AzureResult[azure.RoleAssignment]
 	ListAzureADTenants(ctx context.Context, includeAllTenantCategories bool) <-chan AzureResult[azure.Tenant]
 	ListAzureContainerRegistries(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.ContainerRegistry]
+	ListAzureContainerRegistryTokens(ctx context.Context, containerRegistryId string) <-chan AzureResult[azure.ContainerRegistryToken]
+	ListAzureContainerRegistryScopeMaps(ctx context.Context, containerRegistryId string) <-chan AzureResult[azure.ContainerRegistryScopeMap]
+	ListAzureContainerRegistryWebhooks(ctx context.Context, containerRegistryId string) <-chan AzureResult[azure.ContainerRegistryWebhook]
+	GetAzureContainerRegistryWebhookCallbackConfig(ctx context.Context, webhookId string) (azure.ContainerRegistryWebhookCallbackConfig, error)
 	ListAzureWebApps(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.WebApp]
+	ListAzureSitePublishingCredentialsPolicies(ctx context.Context, siteId string) <-chan AzureResult[azure.SitePublishingCredentialsPolicy]
+	GetAzureSiteAuthSettingsV2(ctx context.Context, siteId string) (azure.SiteAuthSettingsV2, error)
+	GetAzureSiteConfig(ctx context.Context, siteId string) (azure.SiteConfigResource, error)
+	GetAzureSiteSourceControl(ctx context.Context, siteId string) (azure.SiteSourceControl, error)
+	ListAzureCognitiveServicesAccounts(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.CognitiveServicesAccount]
+	ListAzureMachineLearningWorkspaces(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.MachineLearningWorkspace]
+	ListAzureMachineLearningComputes(ctx context.Context, workspaceId string) <-chan AzureResult[azure.MachineLearningCompute]
 	ListAzureManagedClusters(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.ManagedCluster]
+	GetAzureManagedClusterAdminCredentials(ctx context.Context, managedClusterId string) (azure.ManagedClusterCredentials, error)
+	GetAzureManagedClusterUserCredentials(ctx context.Context, managedClusterId string) (azure.ManagedClusterCredentials, error)
+	ListKubernetesClusterRoleBindings(ctx context.Context, kubeconfig []byte) <-chan AzureResult[azure.KubernetesRoleBinding]
+	ListKubernetesRoleBindings(ctx context.Context, kubeconfig []byte) <-chan AzureResult[azure.KubernetesRoleBinding]
 	ListAzureVMScaleSets(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.VMScaleSet]
 	ListAzureKeyVaults(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.KeyVault]
+	ListAzureKeyVaultSecrets(ctx context.Context, vaultUri string) <-chan AzureResult[azure.KeyVaultSecret]
+	ListAzureKeyVaultKeys(ctx context.Context, vaultUri string) <-chan AzureResult[azure.KeyVaultKey]
+	ListAzureKeyVaultCertificates(ctx context.Context, vaultUri string) <-chan AzureResult[azure.KeyVaultCertificate]
 	ListAzureManagementGroups(ctx context.Context, skipToken string) <-chan AzureResult[azure.ManagementGroup]
 	ListAzureManagementGroupDescendants(ctx context.Context, groupId string, top int32) <-chan AzureResult[azure.DescendantInfo]
 	ListAzureResourceGroups(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.ResourceGroup]
+	ListAzureResources(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.Resource]
+	ListAzureResourceGraph(ctx context.Context, kql string, subscriptionIds []string) <-chan AzureResult[json.RawMessage]
+	ListAzureRegistrationDefinitions(ctx context.Context, scope string) <-chan AzureResult[azure.RegistrationDefinition]
+	ListAzureRegistrationAssignments(ctx context.Context, scope string) <-chan AzureResult[azure.RegistrationAssignment]
 	ListAzureSubscriptions(ctx context.Context) <-chan AzureResult[azure.Subscription]
+	ListAzureClassicAdministrators(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.ClassicAdministrator]
+	ListAzureManagementLocks(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.ManagementLock]
+	ListAzureJitNetworkAccessPolicies(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.JitNetworkAccessPolicy]
+	ListAzureBillingAccounts(ctx context.Context) <-chan AzureResult[azure.BillingAccount]
+	ListAzureBillingProfiles(ctx context.Context, billingAccountId string) <-chan AzureResult[azure.BillingAccountProfile]
+	ListAzureInvoiceSections(ctx context.Context, billingProfileId string) <-chan AzureResult[azure.InvoiceSection]
+	ListAzureBillingRoleAssignments(ctx context.Context, scope string) <-chan AzureResult[azure.BillingRoleAssignment]
+	ListAzurePolicyAssignments(ctx context.Context, scope string) <-chan AzureResult[azure.PolicyAssignment]
+	ListAzurePolicyDefinitions(ctx context.Context, scope string) <-chan AzureResult[azure.PolicyDefinition]
+	GetAzurePolicyDefinition(ctx context.Context, policyDefinitionId string) (azure.PolicyDefinition, error)
+	GetAzurePolicySetDefinition(ctx context.Context, policySetDefinitionId string) (azure.PolicySetDefinition, error)
 	ListAzureVirtualMachines(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.VirtualMachine]
 	ListAzureStorageAccounts(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.StorageAccount]
 	ListAzureStorageContainers(ctx context.Context, subscriptionId string, resourceGroupName string, saName string, filter string, includeDeleted string, maxPageSize string) <-chan AzureResult[azure.StorageContainer]
+	ListAzureStorageFileShares(ctx context.Context, storageAccountId string) <-chan AzureResult[azure.StorageFileShare]
+	ListAzureStorageQueues(ctx context.Context, storageAccountId string) <-chan AzureResult[azure.StorageQueue]
+	ListAzureStorageTables(ctx context.Context, storageAccountId string) <-chan AzureResult[azure.StorageTable]
 	ListAzureAutomationAccounts(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.AutomationAccount]
+	ListAzureAutomationRunbooks(ctx context.Context, automationAccountId string) <-chan AzureResult[azure.AutomationRunbook]
+	ListAzureAutomationCredentials(ctx context.Context, automationAccountId string) <-chan AzureResult[azure.AutomationCredential]
+	ListAzureAutomationVariables(ctx context.Context, automationAccountId string) <-chan AzureResult[azure.AutomationVariable]
+	ListAzureAutomationHybridWorkerGroups(ctx context.Context, automationAccountId string) <-chan AzureResult[azure.AutomationHybridWorkerGroup]
 	ListAzureLogicApps(ctx context.Context, subscriptionId string, filter string, top int32) <-chan AzureResult[azure.LogicApp]
+	ListAzureApiConnections(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.ApiConnection]
 	ListAzureFunctionApps(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.FunctionApp]
 }
 
@@ -247,4 +345,5 @@ func (s azureClient) TenantInfo() azure.Tenant {
 func (s azureClient) CloseIdleConnections() {
 	s.msgraph.CloseIdleConnections()
 	s.resourceManager.CloseIdleConnections()
+	s.keyVault.CloseIdleConnections()
 }
@@ -2,20 +2,25 @@ package client
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"io"
 	"net/http"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/bloodhoundad/azurehound/v2/client/config"
 	"github.com/bloodhoundad/azurehound/v2/client/query"
 	"github.com/stretchr/testify/require"
 )
 
 // fakeRestClient is a minimal test double for rest.RestClient that allows
-// controlling the Get response per test case.
+// controlling the Get and Send responses per test case.
 type fakeRestClient struct {
-	getFunc func(ctx context.Context, path string, params query.Params, headers map[string]string) (*http.Response, error)
+	getFunc  func(ctx context.Context, path string, params query.Params, headers map[string]string) (*http.Response, error)
+	postFunc func(ctx context.Context, path string, body interface{}, params query.Params, headers map[string]string) (*http.Response, error)
+	sendFunc func(req *http.Request) (*http.Response, error)
 }
 
 func (s *fakeRestClient) Get(ctx context.Context, path string, params query.Params, headers map[string]string) (*http.Response, error) {
@@ -28,13 +33,21 @@ func (s *fakeRestClient) Delete(context.Context, string, interface{}, query.Para
 func (s *fakeRestClient) Patch(context.Context, string, interface{}, query.Params, map[string]string) (*http.Response, error) {
 	return nil, nil
 }
-func (s *fakeRestClient) Post(context.Context, string, interface{}, query.Params, map[string]string) (*http.Response, error) {
+func (s *fakeRestClient) Post(ctx context.Context, path string, body interface{}, params query.Params, headers map[string]string) (*http.Response, error) {
+	if s.postFunc != nil {
+		return s.postFunc(ctx, path, body, params, headers)
+	}
 	return nil, nil
 }
 func (s *fakeRestClient) Put(context.Context, string, interface{}, query.Params, map[string]string) (*http.Response, error) {
 	return nil, nil
 }
-func (s *fakeRestClient) Send(req *http.Request) (*http.Response, error) { return nil, nil }
+func (s *fakeRestClient) Send(req *http.Request) (*http.Response, error) {
+	if s.sendFunc != nil {
+		return s.sendFunc(req)
+	}
+	return nil, nil
+}
 func (s *fakeRestClient) AddAuthenticationToRequest(req *http.Request) (*http.Request, error) {
 	return req, nil
 }
@@ -129,3 +142,53 @@ func TestGetAzureObjectList_ParentContextCanceled(t *testing.T) {
 	for range out {
 	}
 }
+
+func TestGetAzureObjectList_AbsoluteUrlUsesSend(t *testing.T) {
+	var requested []string
+	client := &fakeRestClient{
+		getFunc: func(ctx context.Context, path string, params query.Params, headers map[string]string) (*http.Response, error) {
+			t.Fatalf("expected absolute url to be sent directly, got Get for %s", path)
+			return nil, nil
+		},
+		sendFunc: func(req *http.Request) (*http.Response, error) {
+			requested = append(requested, req.URL.String())
+			body := `{"value": [{"id": "2"}]}`
+			if len(requested) == 1 {
+				body = `{"value": [{"id": "1"}], "nextLink": "https://vault.example/secrets?$skiptoken=abc"}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		},
+	}
+
+	out := make(chan AzureResult[map[string]string])
+	go getAzureObjectList(client, context.Background(), "https://vault.example/secrets", query.RMParams{ApiVersion: "7.4"}, out)
+
+	var results []map[string]string
+	for result := range out {
+		require.NoError(t, result.Error)
+		results = append(results, result.Ok)
+	}
+
+	require.Len(t, results, 2)
+	require.Len(t, requested, 2)
+	require.Equal(t, "https://vault.example/secrets?api-version=7.4", requested[0])
+	require.Contains(t, requested[1], "%24skiptoken=abc")
+}
+
+func TestNewClient_KeyVaultDataPlaneRequiresVaultCredential(t *testing.T) {
+	jwt := func(aud string) string {
+		claims, _ := json.Marshal(map[string]string{"aud": aud})
+		return "e30." + base64.RawURLEncoding.EncodeToString(claims) + ".signature"
+	}
+
+	cfg := config.Config{Graph: "https://graph.microsoft.com", JWT: jwt("https://graph.microsoft.com"), KeyVaultDataPlane: true}
+	_, err := NewClient(cfg)
+	require.ErrorContains(t, err, "key vault data plane collection requires")
+
+	require.True(t, canAuthenticate(config.Config{JWT: jwt("https://vault.azure.net/")}, "https://vault.azure.net"))
+	require.True(t, canAuthenticate(config.Config{GraphJWT: jwt("https://graph.microsoft.com"), ClientSecret: "secret"}, "https://vault.azure.net"))
+	require.False(t, canAuthenticate(config.Config{MgmtJWT: jwt("https://management.azure.com")}, "https://vault.azure.net"))
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzureCognitiveServicesAccounts https://learn.microsoft.com/en-us/rest/api/aiservices/accountmanagement/accounts/list?view=rest-aiservices-accountmanagement-2023-05-01
+func (s *azureClient) ListAzureCognitiveServicesAccounts(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.CognitiveServicesAccount] {
+	var (
+		out    = make(chan AzureResult[azure.CognitiveServicesAccount])
+		path   = fmt.Sprintf("/subscriptions/%s/providers/Microsoft.CognitiveServices/accounts", subscriptionId)
+		params = query.RMParams{ApiVersion: "2023-05-01"}
+	)
+
+	go getAzureObjectList[azure.CognitiveServicesAccount](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
@@ -25,26 +25,69 @@ import (
 
 type Config struct {
 	ApplicationId           string   // The Application Id that the  Azure app registration portal assigned when the app was registered.
+	AssertionCommand        string   // The command whose output is used as the client assertion of the application, run on every refresh
 	Authority               string   // The Azure ActiveDirectory Authority URL
+	BrowserLogin            bool     // If true then the user signs in through their browser and tokens are refreshed with the refresh token issued to them
 	ClientSecret            string   // The Application Secret that was generated for the app in the app registration portal.
 	ClientCert              string   // The certificate uploaded to the app registration portal."
 	ClientKey               string   // The key for a certificate uploaded to the app registration portal."
 	ClientKeyPass           string   // The passphrase to use in conjuction with the associated key of a certificate uploaded to the app registration portal."
+	FederatedTokenFile      string   // The file from which the client assertion of the application is read on every refresh, e.g. a projected service account token
 	Graph                   string   // The Microsoft Graph URL
+	DeviceCode              bool     // If true then the user signs in with a device code and tokens are refreshed with the refresh token issued to them
+	GraphJWT                string   // The JSON web token that will be used to authenticate requests sent to Microsoft Graph, instead of JWT
+	GraphBatch              bool     // If true then per-object Microsoft Graph requests are combined into JSON $batch calls
 	JWT                     string   // The JSON web token that will be used to authenticate requests sent to Azure APIs
+	KeyVaultDataPlane       bool     // If true then the keys, secrets and certificates of key vaults are listed through their data plane
 	Management              string   // The Azure ResourceManager URL
+	MgmtJWT                 string   // The JSON web token that will be used to authenticate requests sent to Azure Resource Manager, instead of JWT
 	MgmtGroupId             []string // The Management Group Id to use as a filter
 	ManagedIdentity         bool     // If true then the client will use a managed identity to authenticate to Azure APIs
 	ManagedIdentityClientId string   // Client ID of user-assigned managed idenity used to authenticate via managed identity SDK
 	Password                string   // The password associated with the user principal name associated with the Azure portal.
+	PublicClientId          string   // The client ID of the public client application that users sign in to with a device code or their browser
 	ProxyUrl                string   // The forward proxy url
 	RefreshToken            string   // The refresh token that will be used to authenticate requests sent to Azure APIs
+	RefreshClientId         string   // The client ID the refresh token is redeemed as before falling back to the family of first-party clients
+	RetryAttempts           int      // The number of times a failed request is tried
+	RetryDelay              int      // The seconds to wait before retrying a failed request, doubled for each further attempt
+	RetryMaxDelay           int      // The most seconds to wait before retrying a failed request
+	RetryJitter             float64  // The fraction by which each wait before retrying is randomly shortened or lengthened
+	RetryStatusCodes        []int    // The response status codes of failed requests that are tried again
 	Region                  string   // The region of the Azure Cloud deployment.
 	SubscriptionId          []string // The Subscription Id(s) to use as a filter
+	SubRoleAssignments      bool     // If true then the role assignments of each subscription are listed once and per-resource requests are answered from them
 	Tenant                  string   // The directory tenant that you want to request permission from. This can be in GUID or friendly name format
 	Username                string   // The user principal name associated with the Azure portal.
 }
 
+// HasExplicitCredential reports whether a credential other than a client assertion was configured for the API. It takes
+// precedence over a federated token file or assertion command.
+func (s Config) HasExplicitCredential() bool {
+	return s.JWT != "" ||
+		s.RefreshToken != "" ||
+		s.ClientSecret != "" ||
+		(s.ClientCert != "" && s.ClientKey != "") ||
+		(s.Username != "" && s.Password != "") ||
+		s.ManagedIdentity ||
+		s.DeviceCode ||
+		s.BrowserLogin
+}
+
+// CanRequestTokens reports whether a credential was configured that tokens can be requested with for any API, unlike a
+// JWT which is only valid for the API it was issued for.
+func (s Config) CanRequestTokens() bool {
+	return s.RefreshToken != "" ||
+		s.ClientSecret != "" ||
+		(s.ClientCert != "" && s.ClientKey != "") ||
+		(s.Username != "" && s.Password != "") ||
+		s.ManagedIdentity ||
+		s.DeviceCode ||
+		s.BrowserLogin ||
+		s.FederatedTokenFile != "" ||
+		s.AssertionCommand != ""
+}
+
 func AuthorityUrl(region string, defaultUrl string) string {
 	switch region {
 	case constants.China:
@@ -101,3 +144,35 @@ func ResourceManagerUrl(region string, defaultUrl string) string {
 func (s Config) ResourceManagerUrl() string {
 	return strings.TrimSuffix(ResourceManagerUrl(s.Region, s.Graph), "/")
 }
+
+// JWTFor returns the JWT given for the API at the given url, or else the one given for all APIs.
+func (s Config) JWTFor(apiUrl string) string {
+	if apiUrl == s.GraphUrl() && s.GraphJWT != "" {
+		return s.GraphJWT
+	} else if apiUrl == s.ResourceManagerUrl() && s.MgmtJWT != "" {
+		return s.MgmtJWT
+	} else {
+		return s.JWT
+	}
+}
+
+func KeyVaultUrl(region string, defaultUrl string) string {
+	switch region {
+	case constants.China:
+		return constants.AzureChina().KeyVaultUrl
+	case constants.Cloud:
+		return constants.AzureCloud().KeyVaultUrl
+	case constants.USGovL4:
+		return constants.AzureUSGovernment().KeyVaultUrl
+	case constants.USGovL5:
+		return constants.AzureUSGovernmentL5().KeyVaultUrl
+	default:
+		return defaultUrl
+	}
+}
+
+// KeyVaultUrl returns the audience of the Key Vault data plane. Vaults are addressed by their own vault uri, but
+// tokens for all of them are issued for this audience.
+func (s Config) KeyVaultUrl() string {
+	return strings.TrimSuffix(KeyVaultUrl(s.Region, constants.AzureCloud().KeyVaultUrl), "/")
+}
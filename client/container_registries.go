@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/client/rest"
 	"github.com/bloodhoundad/azurehound/v2/models/azure"
 )
 
@@ -37,3 +38,59 @@ func (s *azureClient) ListAzureContainerRegistries(ctx context.Context, subscrip
 
 	return out
 }
+
+// ListAzureContainerRegistryTokens https://learn.microsoft.com/en-us/rest/api/containerregistry/tokens/list?view=rest-containerregistry-2023-01-01-preview
+func (s *azureClient) ListAzureContainerRegistryTokens(ctx context.Context, containerRegistryId string) <-chan AzureResult[azure.ContainerRegistryToken] {
+	var (
+		out    = make(chan AzureResult[azure.ContainerRegistryToken])
+		path   = fmt.Sprintf("%s/tokens", containerRegistryId)
+		params = query.RMParams{ApiVersion: "2023-01-01-preview"}
+	)
+
+	go getAzureObjectList[azure.ContainerRegistryToken](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureContainerRegistryScopeMaps https://learn.microsoft.com/en-us/rest/api/containerregistry/scope-maps/list?view=rest-containerregistry-2023-01-01-preview
+func (s *azureClient) ListAzureContainerRegistryScopeMaps(ctx context.Context, containerRegistryId string) <-chan AzureResult[azure.ContainerRegistryScopeMap] {
+	var (
+		out    = make(chan AzureResult[azure.ContainerRegistryScopeMap])
+		path   = fmt.Sprintf("%s/scopeMaps", containerRegistryId)
+		params = query.RMParams{ApiVersion: "2023-01-01-preview"}
+	)
+
+	go getAzureObjectList[azure.ContainerRegistryScopeMap](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureContainerRegistryWebhooks https://learn.microsoft.com/en-us/rest/api/containerregistry/webhooks/list?view=rest-containerregistry-2023-01-01-preview
+func (s *azureClient) ListAzureContainerRegistryWebhooks(ctx context.Context, containerRegistryId string) <-chan AzureResult[azure.ContainerRegistryWebhook] {
+	var (
+		out    = make(chan AzureResult[azure.ContainerRegistryWebhook])
+		path   = fmt.Sprintf("%s/webhooks", containerRegistryId)
+		params = query.RMParams{ApiVersion: "2023-01-01-preview"}
+	)
+
+	go getAzureObjectList[azure.ContainerRegistryWebhook](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// GetAzureContainerRegistryWebhookCallbackConfig https://learn.microsoft.com/en-us/rest/api/containerregistry/webhooks/get-callback-config?view=rest-containerregistry-2023-01-01-preview
+func (s *azureClient) GetAzureContainerRegistryWebhookCallbackConfig(ctx context.Context, webhookId string) (azure.ContainerRegistryWebhookCallbackConfig, error) {
+	var (
+		path     = fmt.Sprintf("%s/getCallbackConfig", webhookId)
+		params   = query.RMParams{ApiVersion: "2023-01-01-preview"}
+		response azure.ContainerRegistryWebhookCallbackConfig
+	)
+
+	if res, err := s.resourceManager.Post(ctx, path, nil, params, nil); err != nil {
+		return response, err
+	} else if err := rest.Decode(res.Body, &response); err != nil {
+		return response, err
+	} else {
+		return response, nil
+	}
+}
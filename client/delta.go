@@ -0,0 +1,110 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/client/rest"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+)
+
+// getAzureDeltaList runs a round of a Microsoft Graph delta query, resuming from the delta link of the previous round
+// when one is given and listing every object otherwise. The final result of a successful round carries the delta link
+// for the next one.
+// https://learn.microsoft.com/en-us/graph/delta-query-overview
+func getAzureDeltaList[T any](client rest.RestClient, ctx context.Context, path string, deltaLink string, params query.Params, out chan AzureResult[azure.DeltaItem[T]]) {
+	defer panicrecovery.PanicRecovery()
+	defer close(out)
+
+	var (
+		errResult AzureResult[azure.DeltaItem[T]]
+		nextLink  = deltaLink
+	)
+
+	for {
+		var (
+			list struct {
+				NextLinkGraph  string               `json:"@odata.nextLink,omitempty"`
+				DeltaLinkGraph string               `json:"@odata.deltaLink,omitempty"`
+				Value          []azure.DeltaItem[T] `json:"value"`
+			}
+			res *http.Response
+			err error
+		)
+
+		pageCtx, pageCancel := context.WithTimeout(ctx, pageRequestTimeout)
+
+		// Next and delta links already carry the query of the initial request along with the state token
+		if nextLink != "" {
+			if nextUrl, err := url.Parse(nextLink); err != nil {
+				pageCancel()
+				errResult.Error = err
+				_ = pipeline.Send(ctx.Done(), out, errResult)
+				return
+			} else if req, err := rest.NewRequest(pageCtx, "GET", nextUrl, nil, nil, nil); err != nil {
+				pageCancel()
+				errResult.Error = err
+				_ = pipeline.Send(ctx.Done(), out, errResult)
+				return
+			} else if res, err = client.Send(req); err != nil {
+				pageCancel()
+				errResult.Error = err
+				_ = pipeline.Send(ctx.Done(), out, errResult)
+				return
+			}
+		} else if res, err = client.Get(pageCtx, path, params, nil); err != nil {
+			pageCancel()
+			errResult.Error = err
+			_ = pipeline.Send(ctx.Done(), out, errResult)
+			return
+		}
+
+		if err := rest.Decode(res.Body, &list); err != nil {
+			pageCancel()
+			errResult.Error = err
+			_ = pipeline.Send(ctx.Done(), out, errResult)
+			return
+		}
+
+		pageCancel()
+
+		for _, u := range list.Value {
+			if ok := pipeline.Send(ctx.Done(), out, AzureResult[azure.DeltaItem[T]]{Ok: u}); !ok {
+				return
+			}
+		}
+
+		if list.NextLinkGraph != "" {
+			nextLink = list.NextLinkGraph
+		} else if list.DeltaLinkGraph != "" {
+			_ = pipeline.Send(ctx.Done(), out, AzureResult[azure.DeltaItem[T]]{Ok: azure.DeltaItem[T]{DeltaLink: list.DeltaLinkGraph}})
+			return
+		} else {
+			errResult.Error = fmt.Errorf("delta query for %s ended without a delta link", path)
+			_ = pipeline.Send(ctx.Done(), out, errResult)
+			return
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAzureDeltaList_ResumesFromDeltaLink(t *testing.T) {
+	var requested []string
+	client := &fakeRestClient{
+		getFunc: func(ctx context.Context, path string, params query.Params, headers map[string]string) (*http.Response, error) {
+			t.Fatalf("expected delta link to be followed directly, got Get for %s", path)
+			return nil, nil
+		},
+		sendFunc: func(req *http.Request) (*http.Response, error) {
+			requested = append(requested, req.URL.String())
+			body := `{"value": [{"id": "2", "@removed": {"reason": "deleted"}}], "@odata.deltaLink": "https://graph.example/v1.0/users/delta?$deltatoken=new"}`
+			if len(requested) == 1 {
+				body = `{"value": [{"id": "1"}], "@odata.nextLink": "https://graph.example/v1.0/users/delta?$skiptoken=abc"}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		},
+	}
+
+	out := make(chan AzureResult[azure.DeltaItem[azure.User]])
+	go getAzureDeltaList(client, context.Background(), "/v1.0/users/delta", "https://graph.example/v1.0/users/delta?$deltatoken=old", query.GraphParams{Top: 999}, out)
+
+	var results []azure.DeltaItem[azure.User]
+	for result := range out {
+		require.NoError(t, result.Error)
+		results = append(results, result.Ok)
+	}
+
+	require.Len(t, results, 3)
+	require.Equal(t, "1", results[0].Id)
+	require.Nil(t, results[0].Removed)
+	require.Equal(t, "2", results[1].Id)
+	require.Equal(t, azure.DeltaRemovedDeleted, results[1].Removed.Reason)
+	require.Equal(t, "https://graph.example/v1.0/users/delta?$deltatoken=new", results[2].DeltaLink)
+	require.Equal(t, []string{
+		"https://graph.example/v1.0/users/delta?$deltatoken=old",
+		"https://graph.example/v1.0/users/delta?$skiptoken=abc",
+	}, requested)
+}
+
+func TestGetAzureDeltaList_MissingDeltaLink(t *testing.T) {
+	client := &fakeRestClient{
+		getFunc: func(ctx context.Context, path string, params query.Params, headers map[string]string) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"value": [{"id": "1"}]}`)),
+			}, nil
+		},
+	}
+
+	out := make(chan AzureResult[azure.DeltaItem[azure.Group]])
+	go getAzureDeltaList(client, context.Background(), "/v1.0/groups/delta", "", nil, out)
+
+	var results []AzureResult[azure.DeltaItem[azure.Group]]
+	for result := range out {
+		results = append(results, result)
+	}
+
+	require.Len(t, results, 2)
+	require.NoError(t, results[0].Error)
+	require.Error(t, results[1].Error)
+}
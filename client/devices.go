@@ -47,10 +47,10 @@ func (s *azureClient) ListAzureDevices(ctx context.Context, params query.GraphPa
 func (s *azureClient) ListAzureDeviceRegisteredOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage] {
 	var (
 		out  = make(chan AzureResult[json.RawMessage])
-		path = fmt.Sprintf("/%s/devices/%s/registeredOwners", constants.GraphApiBetaVersion, objectId)
+		path = fmt.Sprintf("/devices/%s/registeredOwners", objectId)
 	)
 
-	go getAzureObjectList[json.RawMessage](s.msgraph, ctx, path, params, out)
+	go getGraphObjectList[json.RawMessage](s, ctx, constants.GraphApiBetaVersion, path, params, out)
 
 	return out
 }
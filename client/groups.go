@@ -47,14 +47,14 @@ func (s *azureClient) ListAzureADGroups(ctx context.Context, params query.GraphP
 func (s *azureClient) ListAzureADGroupOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage] {
 	var (
 		out  = make(chan AzureResult[json.RawMessage])
-		path = fmt.Sprintf("/%s/groups/%s/owners", constants.GraphApiBetaVersion, objectId)
+		path = fmt.Sprintf("/groups/%s/owners", objectId)
 	)
 
 	if params.Top == 0 {
 		params.Top = 99
 	}
 
-	go getAzureObjectList[json.RawMessage](s.msgraph, ctx, path, params, out)
+	go getGraphObjectList[json.RawMessage](s, ctx, constants.GraphApiBetaVersion, path, params, out)
 
 	return out
 }
@@ -63,10 +63,22 @@ func (s *azureClient) ListAzureADGroupOwners(ctx context.Context, objectId strin
 func (s *azureClient) ListAzureADGroupMembers(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage] {
 	var (
 		out  = make(chan AzureResult[json.RawMessage])
-		path = fmt.Sprintf("/%s/groups/%s/members", constants.GraphApiBetaVersion, objectId)
+		path = fmt.Sprintf("/groups/%s/members", objectId)
 	)
 
-	go getAzureObjectList[json.RawMessage](s.msgraph, ctx, path, params, out)
+	go getGraphObjectList[json.RawMessage](s, ctx, constants.GraphApiBetaVersion, path, params, out)
+
+	return out
+}
+
+// ListAzureADGroupsDelta https://learn.microsoft.com/en-us/graph/api/group-delta?view=graph-rest-1.0
+func (s *azureClient) ListAzureADGroupsDelta(ctx context.Context, deltaLink string, params query.GraphParams) <-chan AzureResult[azure.DeltaItem[azure.Group]] {
+	var (
+		out  = make(chan AzureResult[azure.DeltaItem[azure.Group]])
+		path = fmt.Sprintf("/%s/groups/delta", constants.GraphApiVersion)
+	)
+
+	go getAzureDeltaList[azure.Group](s.msgraph, ctx, path, deltaLink, params, out)
 
 	return out
 }
@@ -0,0 +1,41 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzureJitNetworkAccessPolicies https://learn.microsoft.com/en-us/rest/api/defenderforcloud/jit-network-access-policies/list?view=rest-defenderforcloud-2020-01-01
+//
+// Lists the policies of every location in the subscription, i.e. each Microsoft.Security/locations/{location}/jitNetworkAccessPolicies resource.
+func (s *azureClient) ListAzureJitNetworkAccessPolicies(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.JitNetworkAccessPolicy] {
+	var (
+		out    = make(chan AzureResult[azure.JitNetworkAccessPolicy])
+		path   = fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Security/jitNetworkAccessPolicies", subscriptionId)
+		params = query.RMParams{ApiVersion: "2020-01-01"}
+	)
+
+	go getAzureObjectList[azure.JitNetworkAccessPolicy](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
@@ -20,6 +20,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/bloodhoundad/azurehound/v2/client/query"
 	"github.com/bloodhoundad/azurehound/v2/models/azure"
@@ -40,3 +41,48 @@ func (s *azureClient) ListAzureKeyVaults(ctx context.Context, subscriptionId str
 
 	return out
 }
+
+// ==
+// Key vault data plane
+// ==
+
+// These only list object metadata; the key vault list operations never return secret values or key material.
+
+// ListAzureKeyVaultSecrets https://learn.microsoft.com/en-us/rest/api/keyvault/secrets/get-secrets/get-secrets?view=rest-keyvault-secrets-7.4
+func (s *azureClient) ListAzureKeyVaultSecrets(ctx context.Context, vaultUri string) <-chan AzureResult[azure.KeyVaultSecret] {
+	var (
+		out    = make(chan AzureResult[azure.KeyVaultSecret])
+		path   = fmt.Sprintf("%s/secrets", strings.TrimSuffix(vaultUri, "/"))
+		params = query.RMParams{ApiVersion: "7.4"}
+	)
+
+	go getAzureObjectList[azure.KeyVaultSecret](s.keyVault, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureKeyVaultKeys https://learn.microsoft.com/en-us/rest/api/keyvault/keys/get-keys/get-keys?view=rest-keyvault-keys-7.4
+func (s *azureClient) ListAzureKeyVaultKeys(ctx context.Context, vaultUri string) <-chan AzureResult[azure.KeyVaultKey] {
+	var (
+		out    = make(chan AzureResult[azure.KeyVaultKey])
+		path   = fmt.Sprintf("%s/keys", strings.TrimSuffix(vaultUri, "/"))
+		params = query.RMParams{ApiVersion: "7.4"}
+	)
+
+	go getAzureObjectList[azure.KeyVaultKey](s.keyVault, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureKeyVaultCertificates https://learn.microsoft.com/en-us/rest/api/keyvault/certificates/get-certificates/get-certificates?view=rest-keyvault-certificates-7.4
+func (s *azureClient) ListAzureKeyVaultCertificates(ctx context.Context, vaultUri string) <-chan AzureResult[azure.KeyVaultCertificate] {
+	var (
+		out    = make(chan AzureResult[azure.KeyVaultCertificate])
+		path   = fmt.Sprintf("%s/certificates", strings.TrimSuffix(vaultUri, "/"))
+		params = query.RMParams{ApiVersion: "7.4"}
+	)
+
+	go getAzureObjectList[azure.KeyVaultCertificate](s.keyVault, ctx, path, params, out)
+
+	return out
+}
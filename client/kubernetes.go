@@ -0,0 +1,263 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/bloodhoundad/azurehound/v2/client/rest"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"gopkg.in/yaml.v3"
+)
+
+const kubernetesPageSize = 500
+
+// The subset of a kubeconfig file needed to reach a cluster's API server.
+// Mapped according to https://kubernetes.io/docs/reference/config-api/kubeconfig.v1/
+type kubeconfig struct {
+	Clusters []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+			Server                   string `yaml:"server"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	CurrentContext string `yaml:"current-context"`
+	Users          []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Token                 string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+type kubernetesClient struct {
+	server *url.URL
+	http   *http.Client
+	token  string
+
+	// Authenticates requests with an Entra token when the kubeconfig user has no token or client certificate, as
+	// is the case for the user credentials of clusters with Microsoft Entra ID integration.
+	entra rest.RestClient
+}
+
+func newKubernetesClient(data []byte, proxyUrl string, entra rest.RestClient) (*kubernetesClient, error) {
+	var (
+		config      kubeconfig
+		clusterName string
+		userName    string
+		client      kubernetesClient
+		tlsConfig   = &tls.Config{MinVersion: tls.VersionTLS12}
+	)
+
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("unable to parse kubeconfig: %w", err)
+	}
+
+	for _, ctx := range config.Contexts {
+		if ctx.Name == config.CurrentContext {
+			clusterName, userName = ctx.Context.Cluster, ctx.Context.User
+		}
+	}
+
+	for _, cluster := range config.Clusters {
+		if cluster.Name != clusterName {
+			continue
+		} else if server, err := url.Parse(cluster.Cluster.Server); err != nil {
+			return nil, err
+		} else {
+			client.server = server
+		}
+
+		if cluster.Cluster.CertificateAuthorityData != "" {
+			if ca, err := base64.StdEncoding.DecodeString(cluster.Cluster.CertificateAuthorityData); err != nil {
+				return nil, fmt.Errorf("unable to decode certificate authority data: %w", err)
+			} else {
+				tlsConfig.RootCAs = x509.NewCertPool()
+				if ok := tlsConfig.RootCAs.AppendCertsFromPEM(ca); !ok {
+					return nil, fmt.Errorf("unable to parse certificate authority data")
+				}
+			}
+		}
+	}
+
+	if client.server == nil {
+		return nil, fmt.Errorf("kubeconfig does not define a cluster for the current context %q", config.CurrentContext)
+	}
+
+	for _, user := range config.Users {
+		if user.Name != userName {
+			continue
+		}
+
+		client.token = user.User.Token
+		if user.User.Token == "" && user.User.ClientCertificateData == "" {
+			client.entra = entra
+		} else if user.User.ClientCertificateData != "" {
+			if cert, err := base64.StdEncoding.DecodeString(user.User.ClientCertificateData); err != nil {
+				return nil, fmt.Errorf("unable to decode client certificate data: %w", err)
+			} else if key, err := base64.StdEncoding.DecodeString(user.User.ClientKeyData); err != nil {
+				return nil, fmt.Errorf("unable to decode client key data: %w", err)
+			} else if keyPair, err := tls.X509KeyPair(cert, key); err != nil {
+				return nil, fmt.Errorf("unable to load client certificate: %w", err)
+			} else {
+				tlsConfig.Certificates = []tls.Certificate{keyPair}
+			}
+		}
+	}
+
+	if httpClient, err := rest.NewHTTPClient(proxyUrl); err != nil {
+		return nil, err
+	} else {
+		httpClient.Transport.(*http.Transport).TLSClientConfig = tlsConfig
+		client.http = httpClient
+	}
+
+	return &client, nil
+}
+
+func (s *kubernetesClient) get(ctx context.Context, path string, continueToken string) (*http.Response, error) {
+	endpoint := s.server.ResolveReference(&url.URL{Path: path})
+	params := map[string]string{"limit": strconv.Itoa(kubernetesPageSize)}
+	if continueToken != "" {
+		params["continue"] = continueToken
+	}
+
+	if req, err := rest.NewRequest(ctx, http.MethodGet, endpoint, nil, params, nil); err != nil {
+		return nil, err
+	} else {
+		if s.token != "" {
+			req.Header.Set("Authorization", "Bearer "+s.token)
+		} else if s.entra != nil {
+			if _, err := s.entra.AddAuthenticationToRequest(req); err != nil {
+				return nil, err
+			}
+		}
+
+		if res, err := s.http.Do(req); err != nil {
+			return nil, err
+		} else if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+			defer res.Body.Close()
+			var errRes map[string]interface{}
+			if err := rest.Decode(res.Body, &errRes); err != nil {
+				return nil, fmt.Errorf("malformed error response, status code: %d", res.StatusCode)
+			} else {
+				return nil, fmt.Errorf("%v", errRes)
+			}
+		} else {
+			return res, nil
+		}
+	}
+}
+
+func getKubernetesObjectList[T any](ctx context.Context, azClient *azureClient, kubeconfig []byte, path string, out chan AzureResult[T]) {
+	defer panicrecovery.PanicRecovery()
+	defer close(out)
+
+	var (
+		errResult     AzureResult[T]
+		continueToken string
+	)
+
+	client, err := newKubernetesClient(kubeconfig, azClient.proxyUrl, azClient.kubernetes)
+	if err != nil {
+		errResult.Error = err
+		_ = pipeline.Send(ctx.Done(), out, errResult)
+		return
+	}
+	defer client.http.CloseIdleConnections()
+
+	for {
+		var list struct {
+			Items    []T `json:"items"`
+			Metadata struct {
+				Continue string `json:"continue,omitempty"` // The token to use for getting the next set of values.
+			} `json:"metadata"`
+		}
+
+		pageCtx, pageCancel := context.WithTimeout(ctx, pageRequestTimeout)
+
+		if res, err := client.get(pageCtx, path, continueToken); err != nil {
+			pageCancel()
+			errResult.Error = err
+			_ = pipeline.Send(ctx.Done(), out, errResult)
+			return
+		} else if err := rest.Decode(res.Body, &list); err != nil {
+			pageCancel()
+			errResult.Error = err
+			_ = pipeline.Send(ctx.Done(), out, errResult)
+			return
+		}
+
+		pageCancel()
+
+		for _, item := range list.Items {
+			if ok := pipeline.Send(ctx.Done(), out, AzureResult[T]{Ok: item}); !ok {
+				return
+			}
+		}
+
+		if list.Metadata.Continue == "" {
+			break
+		} else {
+			continueToken = list.Metadata.Continue
+		}
+	}
+}
+
+// ListKubernetesClusterRoleBindings https://kubernetes.io/docs/reference/kubernetes-api/authorization-resources/cluster-role-binding-v1/#list-list-or-watch-objects-of-kind-clusterrolebinding
+func (s *azureClient) ListKubernetesClusterRoleBindings(ctx context.Context, kubeconfig []byte) <-chan AzureResult[azure.KubernetesRoleBinding] {
+	var (
+		out  = make(chan AzureResult[azure.KubernetesRoleBinding])
+		path = "/apis/rbac.authorization.k8s.io/v1/clusterrolebindings"
+	)
+
+	go getKubernetesObjectList[azure.KubernetesRoleBinding](ctx, s, kubeconfig, path, out)
+
+	return out
+}
+
+// ListKubernetesRoleBindings https://kubernetes.io/docs/reference/kubernetes-api/authorization-resources/role-binding-v1/#list-list-or-watch-objects-of-kind-rolebinding-1
+func (s *azureClient) ListKubernetesRoleBindings(ctx context.Context, kubeconfig []byte) <-chan AzureResult[azure.KubernetesRoleBinding] {
+	var (
+		out  = make(chan AzureResult[azure.KubernetesRoleBinding])
+		path = "/apis/rbac.authorization.k8s.io/v1/rolebindings"
+	)
+
+	go getKubernetesObjectList[azure.KubernetesRoleBinding](ctx, s, kubeconfig, path, out)
+
+	return out
+}
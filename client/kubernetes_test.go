@@ -0,0 +1,173 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func testKubeconfig(server *httptest.Server, token string) []byte {
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	return []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: test
+clusters:
+- name: test
+  cluster:
+    server: %s
+    certificate-authority-data: %s
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test-admin
+users:
+- name: test-admin
+  user:
+    token: %s
+`, server.URL, base64.StdEncoding.EncodeToString(ca), token))
+}
+
+func TestListKubernetesClusterRoleBindings_FollowsContinueToken(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/rbac.authorization.k8s.io/v1/clusterrolebindings" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		} else if auth := r.Header.Get("Authorization"); auth != "Bearer test-token" {
+			t.Errorf("unexpected authorization header: %s", auth)
+		}
+
+		switch r.URL.Query().Get("continue") {
+		case "":
+			fmt.Fprint(w, `{"metadata":{"continue":"page-2"},"items":[{"metadata":{"name":"aks-admins"},"roleRef":{"kind":"ClusterRole","name":"cluster-admin"},"subjects":[{"kind":"Group","name":"00000000-0000-0000-0000-000000000001"}]}]}`)
+		case "page-2":
+			fmt.Fprint(w, `{"metadata":{},"items":[{"metadata":{"name":"system:basic-user"},"roleRef":{"kind":"ClusterRole","name":"system:basic-user"},"subjects":[{"kind":"Group","name":"system:authenticated"}]}]}`)
+		default:
+			t.Errorf("unexpected continue token: %s", r.URL.Query().Get("continue"))
+		}
+	}))
+	defer server.Close()
+
+	client := &azureClient{}
+	var names []string
+	for item := range client.ListKubernetesClusterRoleBindings(context.Background(), testKubeconfig(server, "test-token")) {
+		if item.Error != nil {
+			t.Fatalf("unexpected error: %v", item.Error)
+		}
+		names = append(names, item.Ok.Metadata.Name)
+	}
+
+	if len(names) != 2 || names[0] != "aks-admins" || names[1] != "system:basic-user" {
+		t.Fatalf("unexpected role bindings: %v", names)
+	}
+}
+
+func TestListKubernetesRoleBindings_ReturnsApiServerError(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"kind":"Status","reason":"Forbidden"}`)
+	}))
+	defer server.Close()
+
+	client := &azureClient{}
+	var results int
+	for item := range client.ListKubernetesRoleBindings(context.Background(), testKubeconfig(server, "test-token")) {
+		results++
+		if item.Error == nil {
+			t.Fatalf("expected an error for a forbidden response")
+		}
+	}
+
+	if results != 1 {
+		t.Fatalf("expected exactly one result, got %d", results)
+	}
+}
+
+func TestNewKubernetesClient_MissingCurrentContext(t *testing.T) {
+	if _, err := newKubernetesClient([]byte("apiVersion: v1\nkind: Config\n"), "", nil); err == nil {
+		t.Fatalf("expected an error for a kubeconfig without clusters")
+	}
+}
+
+func TestNewKubernetesClient_UsesProxy(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+
+	client, err := newKubernetesClient(testKubeconfig(server, "test-token"), "http://proxy.example.com:8080", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	transport := client.http.Transport.(*http.Transport)
+	if transport.TLSClientConfig.RootCAs == nil {
+		t.Fatalf("expected the cluster certificate authority to be trusted")
+	}
+
+	req := httptest.NewRequest(http.MethodGet, server.URL, nil)
+	if proxy, err := transport.Proxy(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if proxy == nil || proxy.Host != "proxy.example.com:8080" {
+		t.Fatalf("expected requests to be sent through the proxy, got %v", proxy)
+	}
+}
+
+// entraRestClient stands in for the client that requests Entra tokens for AKS API servers
+type entraRestClient struct {
+	fakeRestClient
+}
+
+func (s *entraRestClient) AddAuthenticationToRequest(req *http.Request) (*http.Request, error) {
+	req.Header.Set("Authorization", "Bearer entra-token")
+	return req, nil
+}
+
+func TestListKubernetesClusterRoleBindings_EntraUserCredentials(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "Bearer entra-token" {
+			t.Errorf("unexpected authorization header: %s", auth)
+		}
+		fmt.Fprint(w, `{"metadata":{},"items":[{"metadata":{"name":"aks-admins"},"roleRef":{"kind":"ClusterRole","name":"cluster-admin"},"subjects":[{"kind":"Group","name":"00000000-0000-0000-0000-000000000001"}]}]}`)
+	}))
+	defer server.Close()
+
+	// The user credentials of clusters with local accounts disabled authenticate through kubelogin
+	kubeconfig := strings.Replace(string(testKubeconfig(server, "")), "    token: \n", `    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: kubelogin
+      args: ["get-token", "--server-id", "6dae42f8-4368-4678-94ff-3960e28e3630"]
+`, 1)
+
+	client := &azureClient{kubernetes: &entraRestClient{}}
+	var names []string
+	for item := range client.ListKubernetesClusterRoleBindings(context.Background(), []byte(kubeconfig)) {
+		if item.Error != nil {
+			t.Fatalf("unexpected error: %v", item.Error)
+		}
+		names = append(names, item.Ok.Metadata.Name)
+	}
+
+	if len(names) != 1 || names[0] != "aks-admins" {
+		t.Fatalf("unexpected role bindings: %v", names)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzureMachineLearningWorkspaces https://learn.microsoft.com/en-us/rest/api/azureml/workspaces/list-by-subscription?view=rest-azureml-2023-10-01
+func (s *azureClient) ListAzureMachineLearningWorkspaces(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.MachineLearningWorkspace] {
+	var (
+		out    = make(chan AzureResult[azure.MachineLearningWorkspace])
+		path   = fmt.Sprintf("/subscriptions/%s/providers/Microsoft.MachineLearningServices/workspaces", subscriptionId)
+		params = query.RMParams{ApiVersion: "2023-10-01"}
+	)
+
+	go getAzureObjectList[azure.MachineLearningWorkspace](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureMachineLearningComputes https://learn.microsoft.com/en-us/rest/api/azureml/compute/list?view=rest-azureml-2023-10-01
+func (s *azureClient) ListAzureMachineLearningComputes(ctx context.Context, workspaceId string) <-chan AzureResult[azure.MachineLearningCompute] {
+	var (
+		out    = make(chan AzureResult[azure.MachineLearningCompute])
+		path   = fmt.Sprintf("%s/computes", workspaceId)
+		params = query.RMParams{ApiVersion: "2023-10-01"}
+	)
+
+	go getAzureObjectList[azure.MachineLearningCompute](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
@@ -22,18 +22,53 @@ import (
 	"fmt"
 
 	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/client/rest"
 	"github.com/bloodhoundad/azurehound/v2/models/azure"
 )
 
-// ListAzureManagedClusters https://learn.microsoft.com/en-us/rest/api/servicefabric/managedclusters/managed-clusters/list-by-subscription?view=rest-servicefabric-managedclusters-2021-07-01
+// ListAzureManagedClusters https://learn.microsoft.com/en-us/rest/api/aks/managed-clusters/list?view=rest-aks-2023-08-01
 func (s *azureClient) ListAzureManagedClusters(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.ManagedCluster] {
 	var (
 		out    = make(chan AzureResult[azure.ManagedCluster])
 		path   = fmt.Sprintf("/subscriptions/%s/providers/Microsoft.ContainerService/managedClusters", subscriptionId)
-		params = query.RMParams{ApiVersion: "2021-07-01"}
+		params = query.RMParams{ApiVersion: "2023-08-01"}
 	)
 
 	go getAzureObjectList[azure.ManagedCluster](s.resourceManager, ctx, path, params, out)
 
 	return out
 }
+
+// GetAzureManagedClusterAdminCredentials https://learn.microsoft.com/en-us/rest/api/aks/managed-clusters/list-cluster-admin-credentials?view=rest-aks-2023-08-01
+func (s *azureClient) GetAzureManagedClusterAdminCredentials(ctx context.Context, managedClusterId string) (azure.ManagedClusterCredentials, error) {
+	var (
+		path     = fmt.Sprintf("%s/listClusterAdminCredential", managedClusterId)
+		params   = query.RMParams{ApiVersion: "2023-08-01"}
+		response azure.ManagedClusterCredentials
+	)
+
+	if res, err := s.resourceManager.Post(ctx, path, nil, params, nil); err != nil {
+		return response, err
+	} else if err := rest.Decode(res.Body, &response); err != nil {
+		return response, err
+	} else {
+		return response, nil
+	}
+}
+
+// GetAzureManagedClusterUserCredentials https://learn.microsoft.com/en-us/rest/api/aks/managed-clusters/list-cluster-user-credentials?view=rest-aks-2023-08-01
+func (s *azureClient) GetAzureManagedClusterUserCredentials(ctx context.Context, managedClusterId string) (azure.ManagedClusterCredentials, error) {
+	var (
+		path     = fmt.Sprintf("%s/listClusterUserCredential", managedClusterId)
+		params   = query.RMParams{ApiVersion: "2023-08-01"}
+		response azure.ManagedClusterCredentials
+	)
+
+	if res, err := s.resourceManager.Post(ctx, path, nil, params, nil); err != nil {
+		return response, err
+	} else if err := rest.Decode(res.Body, &response); err != nil {
+		return response, err
+	} else {
+		return response, nil
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzureRegistrationDefinitions https://learn.microsoft.com/en-us/rest/api/managedservices/registration-definitions/list?view=rest-managedservices-2022-10-01
+func (s *azureClient) ListAzureRegistrationDefinitions(ctx context.Context, scope string) <-chan AzureResult[azure.RegistrationDefinition] {
+	var (
+		out    = make(chan AzureResult[azure.RegistrationDefinition])
+		path   = fmt.Sprintf("%s/providers/Microsoft.ManagedServices/registrationDefinitions", scope)
+		params = query.RMParams{ApiVersion: "2022-10-01"}
+	)
+
+	go getAzureObjectList[azure.RegistrationDefinition](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureRegistrationAssignments https://learn.microsoft.com/en-us/rest/api/managedservices/registration-assignments/list?view=rest-managedservices-2022-10-01
+func (s *azureClient) ListAzureRegistrationAssignments(ctx context.Context, scope string) <-chan AzureResult[azure.RegistrationAssignment] {
+	var (
+		out    = make(chan AzureResult[azure.RegistrationAssignment])
+		path   = fmt.Sprintf("%s/providers/Microsoft.ManagedServices/registrationAssignments", scope)
+		params = query.RMParams{ApiVersion: "2022-10-01", ExpandRegistrationDef: true}
+	)
+
+	go getAzureObjectList[azure.RegistrationAssignment](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
@@ -86,6 +86,126 @@ func (mr *MockAzureClientMockRecorder) GetAzureADTenants(ctx, includeAllTenantCa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzureADTenants", reflect.TypeOf((*MockAzureClient)(nil).GetAzureADTenants), ctx, includeAllTenantCategories)
 }
 
+// GetAzureContainerRegistryWebhookCallbackConfig mocks base method.
+func (m *MockAzureClient) GetAzureContainerRegistryWebhookCallbackConfig(ctx context.Context, webhookId string) (azure.ContainerRegistryWebhookCallbackConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAzureContainerRegistryWebhookCallbackConfig", ctx, webhookId)
+	ret0, _ := ret[0].(azure.ContainerRegistryWebhookCallbackConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAzureContainerRegistryWebhookCallbackConfig indicates an expected call of GetAzureContainerRegistryWebhookCallbackConfig.
+func (mr *MockAzureClientMockRecorder) GetAzureContainerRegistryWebhookCallbackConfig(ctx, webhookId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzureContainerRegistryWebhookCallbackConfig", reflect.TypeOf((*MockAzureClient)(nil).GetAzureContainerRegistryWebhookCallbackConfig), ctx, webhookId)
+}
+
+// GetAzureManagedClusterAdminCredentials mocks base method.
+func (m *MockAzureClient) GetAzureManagedClusterAdminCredentials(ctx context.Context, managedClusterId string) (azure.ManagedClusterCredentials, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAzureManagedClusterAdminCredentials", ctx, managedClusterId)
+	ret0, _ := ret[0].(azure.ManagedClusterCredentials)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAzureManagedClusterAdminCredentials indicates an expected call of GetAzureManagedClusterAdminCredentials.
+func (mr *MockAzureClientMockRecorder) GetAzureManagedClusterAdminCredentials(ctx, managedClusterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzureManagedClusterAdminCredentials", reflect.TypeOf((*MockAzureClient)(nil).GetAzureManagedClusterAdminCredentials), ctx, managedClusterId)
+}
+
+// GetAzureManagedClusterUserCredentials mocks base method.
+func (m *MockAzureClient) GetAzureManagedClusterUserCredentials(ctx context.Context, managedClusterId string) (azure.ManagedClusterCredentials, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAzureManagedClusterUserCredentials", ctx, managedClusterId)
+	ret0, _ := ret[0].(azure.ManagedClusterCredentials)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAzureManagedClusterUserCredentials indicates an expected call of GetAzureManagedClusterUserCredentials.
+func (mr *MockAzureClientMockRecorder) GetAzureManagedClusterUserCredentials(ctx, managedClusterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzureManagedClusterUserCredentials", reflect.TypeOf((*MockAzureClient)(nil).GetAzureManagedClusterUserCredentials), ctx, managedClusterId)
+}
+
+// GetAzurePolicyDefinition mocks base method.
+func (m *MockAzureClient) GetAzurePolicyDefinition(ctx context.Context, policyDefinitionId string) (azure.PolicyDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAzurePolicyDefinition", ctx, policyDefinitionId)
+	ret0, _ := ret[0].(azure.PolicyDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAzurePolicyDefinition indicates an expected call of GetAzurePolicyDefinition.
+func (mr *MockAzureClientMockRecorder) GetAzurePolicyDefinition(ctx, policyDefinitionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzurePolicyDefinition", reflect.TypeOf((*MockAzureClient)(nil).GetAzurePolicyDefinition), ctx, policyDefinitionId)
+}
+
+// GetAzurePolicySetDefinition mocks base method.
+func (m *MockAzureClient) GetAzurePolicySetDefinition(ctx context.Context, policySetDefinitionId string) (azure.PolicySetDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAzurePolicySetDefinition", ctx, policySetDefinitionId)
+	ret0, _ := ret[0].(azure.PolicySetDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAzurePolicySetDefinition indicates an expected call of GetAzurePolicySetDefinition.
+func (mr *MockAzureClientMockRecorder) GetAzurePolicySetDefinition(ctx, policySetDefinitionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzurePolicySetDefinition", reflect.TypeOf((*MockAzureClient)(nil).GetAzurePolicySetDefinition), ctx, policySetDefinitionId)
+}
+
+// GetAzureSiteAuthSettingsV2 mocks base method.
+func (m *MockAzureClient) GetAzureSiteAuthSettingsV2(ctx context.Context, siteId string) (azure.SiteAuthSettingsV2, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAzureSiteAuthSettingsV2", ctx, siteId)
+	ret0, _ := ret[0].(azure.SiteAuthSettingsV2)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAzureSiteAuthSettingsV2 indicates an expected call of GetAzureSiteAuthSettingsV2.
+func (mr *MockAzureClientMockRecorder) GetAzureSiteAuthSettingsV2(ctx, siteId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzureSiteAuthSettingsV2", reflect.TypeOf((*MockAzureClient)(nil).GetAzureSiteAuthSettingsV2), ctx, siteId)
+}
+
+// GetAzureSiteConfig mocks base method.
+func (m *MockAzureClient) GetAzureSiteConfig(ctx context.Context, siteId string) (azure.SiteConfigResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAzureSiteConfig", ctx, siteId)
+	ret0, _ := ret[0].(azure.SiteConfigResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAzureSiteConfig indicates an expected call of GetAzureSiteConfig.
+func (mr *MockAzureClientMockRecorder) GetAzureSiteConfig(ctx, siteId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzureSiteConfig", reflect.TypeOf((*MockAzureClient)(nil).GetAzureSiteConfig), ctx, siteId)
+}
+
+// GetAzureSiteSourceControl mocks base method.
+func (m *MockAzureClient) GetAzureSiteSourceControl(ctx context.Context, siteId string) (azure.SiteSourceControl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAzureSiteSourceControl", ctx, siteId)
+	ret0, _ := ret[0].(azure.SiteSourceControl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAzureSiteSourceControl indicates an expected call of GetAzureSiteSourceControl.
+func (mr *MockAzureClientMockRecorder) GetAzureSiteSourceControl(ctx, siteId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzureSiteSourceControl", reflect.TypeOf((*MockAzureClient)(nil).GetAzureSiteSourceControl), ctx, siteId)
+}
+
 // ListAzureADAppFICs mocks base method.
 func (m *MockAzureClient) ListAzureADAppFICs(ctx context.Context, objectId string, params query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
 	m.ctrl.T.Helper()
@@ -142,6 +262,20 @@ func (mr *MockAzureClientMockRecorder) ListAzureADApps(ctx, params any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADApps", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADApps), ctx, params)
 }
 
+// ListAzureADAppsDelta mocks base method.
+func (m *MockAzureClient) ListAzureADAppsDelta(ctx context.Context, deltaLink string, params query.GraphParams) <-chan client.AzureResult[azure.DeltaItem[azure.Application]] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADAppsDelta", ctx, deltaLink, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.DeltaItem[azure.Application]])
+	return ret0
+}
+
+// ListAzureADAppsDelta indicates an expected call of ListAzureADAppsDelta.
+func (mr *MockAzureClientMockRecorder) ListAzureADAppsDelta(ctx, deltaLink, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADAppsDelta", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADAppsDelta), ctx, deltaLink, params)
+}
+
 // ListAzureADGroupMembers mocks base method.
 func (m *MockAzureClient) ListAzureADGroupMembers(ctx context.Context, objectId string, params query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
 	m.ctrl.T.Helper()
@@ -184,6 +318,20 @@ func (mr *MockAzureClientMockRecorder) ListAzureADGroups(ctx, params any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADGroups", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADGroups), ctx, params)
 }
 
+// ListAzureADGroupsDelta mocks base method.
+func (m *MockAzureClient) ListAzureADGroupsDelta(ctx context.Context, deltaLink string, params query.GraphParams) <-chan client.AzureResult[azure.DeltaItem[azure.Group]] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADGroupsDelta", ctx, deltaLink, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.DeltaItem[azure.Group]])
+	return ret0
+}
+
+// ListAzureADGroupsDelta indicates an expected call of ListAzureADGroupsDelta.
+func (mr *MockAzureClientMockRecorder) ListAzureADGroupsDelta(ctx, deltaLink, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADGroupsDelta", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADGroupsDelta), ctx, deltaLink, params)
+}
+
 // ListAzureADRoleAssignments mocks base method.
 func (m *MockAzureClient) ListAzureADRoleAssignments(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.UnifiedRoleAssignment] {
 	m.ctrl.T.Helper()
@@ -240,6 +388,20 @@ func (mr *MockAzureClientMockRecorder) ListAzureADServicePrincipals(ctx, params
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADServicePrincipals", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADServicePrincipals), ctx, params)
 }
 
+// ListAzureADServicePrincipalsDelta mocks base method.
+func (m *MockAzureClient) ListAzureADServicePrincipalsDelta(ctx context.Context, deltaLink string, params query.GraphParams) <-chan client.AzureResult[azure.DeltaItem[azure.ServicePrincipal]] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADServicePrincipalsDelta", ctx, deltaLink, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.DeltaItem[azure.ServicePrincipal]])
+	return ret0
+}
+
+// ListAzureADServicePrincipalsDelta indicates an expected call of ListAzureADServicePrincipalsDelta.
+func (mr *MockAzureClientMockRecorder) ListAzureADServicePrincipalsDelta(ctx, deltaLink, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADServicePrincipalsDelta", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADServicePrincipalsDelta), ctx, deltaLink, params)
+}
+
 // ListAzureADTenants mocks base method.
 func (m *MockAzureClient) ListAzureADTenants(ctx context.Context, includeAllTenantCategories bool) <-chan client.AzureResult[azure.Tenant] {
 	m.ctrl.T.Helper()
@@ -268,6 +430,34 @@ func (mr *MockAzureClientMockRecorder) ListAzureADUsers(ctx, params any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADUsers", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADUsers), ctx, params)
 }
 
+// ListAzureADUsersDelta mocks base method.
+func (m *MockAzureClient) ListAzureADUsersDelta(ctx context.Context, deltaLink string, params query.GraphParams) <-chan client.AzureResult[azure.DeltaItem[azure.User]] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADUsersDelta", ctx, deltaLink, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.DeltaItem[azure.User]])
+	return ret0
+}
+
+// ListAzureADUsersDelta indicates an expected call of ListAzureADUsersDelta.
+func (mr *MockAzureClientMockRecorder) ListAzureADUsersDelta(ctx, deltaLink, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADUsersDelta", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADUsersDelta), ctx, deltaLink, params)
+}
+
+// ListAzureApiConnections mocks base method.
+func (m *MockAzureClient) ListAzureApiConnections(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.ApiConnection] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureApiConnections", ctx, subscriptionId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.ApiConnection])
+	return ret0
+}
+
+// ListAzureApiConnections indicates an expected call of ListAzureApiConnections.
+func (mr *MockAzureClientMockRecorder) ListAzureApiConnections(ctx, subscriptionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureApiConnections", reflect.TypeOf((*MockAzureClient)(nil).ListAzureApiConnections), ctx, subscriptionId)
+}
+
 // ListAzureAutomationAccounts mocks base method.
 func (m *MockAzureClient) ListAzureAutomationAccounts(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.AutomationAccount] {
 	m.ctrl.T.Helper()
@@ -282,6 +472,132 @@ func (mr *MockAzureClientMockRecorder) ListAzureAutomationAccounts(ctx, subscrip
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureAutomationAccounts", reflect.TypeOf((*MockAzureClient)(nil).ListAzureAutomationAccounts), ctx, subscriptionId)
 }
 
+// ListAzureAutomationCredentials mocks base method.
+func (m *MockAzureClient) ListAzureAutomationCredentials(ctx context.Context, automationAccountId string) <-chan client.AzureResult[azure.AutomationCredential] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureAutomationCredentials", ctx, automationAccountId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.AutomationCredential])
+	return ret0
+}
+
+// ListAzureAutomationCredentials indicates an expected call of ListAzureAutomationCredentials.
+func (mr *MockAzureClientMockRecorder) ListAzureAutomationCredentials(ctx, automationAccountId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureAutomationCredentials", reflect.TypeOf((*MockAzureClient)(nil).ListAzureAutomationCredentials), ctx, automationAccountId)
+}
+
+// ListAzureAutomationHybridWorkerGroups mocks base method.
+func (m *MockAzureClient) ListAzureAutomationHybridWorkerGroups(ctx context.Context, automationAccountId string) <-chan client.AzureResult[azure.AutomationHybridWorkerGroup] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureAutomationHybridWorkerGroups", ctx, automationAccountId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.AutomationHybridWorkerGroup])
+	return ret0
+}
+
+// ListAzureAutomationHybridWorkerGroups indicates an expected call of ListAzureAutomationHybridWorkerGroups.
+func (mr *MockAzureClientMockRecorder) ListAzureAutomationHybridWorkerGroups(ctx, automationAccountId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureAutomationHybridWorkerGroups", reflect.TypeOf((*MockAzureClient)(nil).ListAzureAutomationHybridWorkerGroups), ctx, automationAccountId)
+}
+
+// ListAzureAutomationRunbooks mocks base method.
+func (m *MockAzureClient) ListAzureAutomationRunbooks(ctx context.Context, automationAccountId string) <-chan client.AzureResult[azure.AutomationRunbook] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureAutomationRunbooks", ctx, automationAccountId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.AutomationRunbook])
+	return ret0
+}
+
+// ListAzureAutomationRunbooks indicates an expected call of ListAzureAutomationRunbooks.
+func (mr *MockAzureClientMockRecorder) ListAzureAutomationRunbooks(ctx, automationAccountId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureAutomationRunbooks", reflect.TypeOf((*MockAzureClient)(nil).ListAzureAutomationRunbooks), ctx, automationAccountId)
+}
+
+// ListAzureAutomationVariables mocks base method.
+func (m *MockAzureClient) ListAzureAutomationVariables(ctx context.Context, automationAccountId string) <-chan client.AzureResult[azure.AutomationVariable] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureAutomationVariables", ctx, automationAccountId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.AutomationVariable])
+	return ret0
+}
+
+// ListAzureAutomationVariables indicates an expected call of ListAzureAutomationVariables.
+func (mr *MockAzureClientMockRecorder) ListAzureAutomationVariables(ctx, automationAccountId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureAutomationVariables", reflect.TypeOf((*MockAzureClient)(nil).ListAzureAutomationVariables), ctx, automationAccountId)
+}
+
+// ListAzureBillingAccounts mocks base method.
+func (m *MockAzureClient) ListAzureBillingAccounts(ctx context.Context) <-chan client.AzureResult[azure.BillingAccount] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureBillingAccounts", ctx)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.BillingAccount])
+	return ret0
+}
+
+// ListAzureBillingAccounts indicates an expected call of ListAzureBillingAccounts.
+func (mr *MockAzureClientMockRecorder) ListAzureBillingAccounts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureBillingAccounts", reflect.TypeOf((*MockAzureClient)(nil).ListAzureBillingAccounts), ctx)
+}
+
+// ListAzureBillingProfiles mocks base method.
+func (m *MockAzureClient) ListAzureBillingProfiles(ctx context.Context, billingAccountId string) <-chan client.AzureResult[azure.BillingAccountProfile] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureBillingProfiles", ctx, billingAccountId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.BillingAccountProfile])
+	return ret0
+}
+
+// ListAzureBillingProfiles indicates an expected call of ListAzureBillingProfiles.
+func (mr *MockAzureClientMockRecorder) ListAzureBillingProfiles(ctx, billingAccountId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureBillingProfiles", reflect.TypeOf((*MockAzureClient)(nil).ListAzureBillingProfiles), ctx, billingAccountId)
+}
+
+// ListAzureBillingRoleAssignments mocks base method.
+func (m *MockAzureClient) ListAzureBillingRoleAssignments(ctx context.Context, scope string) <-chan client.AzureResult[azure.BillingRoleAssignment] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureBillingRoleAssignments", ctx, scope)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.BillingRoleAssignment])
+	return ret0
+}
+
+// ListAzureBillingRoleAssignments indicates an expected call of ListAzureBillingRoleAssignments.
+func (mr *MockAzureClientMockRecorder) ListAzureBillingRoleAssignments(ctx, scope any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureBillingRoleAssignments", reflect.TypeOf((*MockAzureClient)(nil).ListAzureBillingRoleAssignments), ctx, scope)
+}
+
+// ListAzureClassicAdministrators mocks base method.
+func (m *MockAzureClient) ListAzureClassicAdministrators(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.ClassicAdministrator] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureClassicAdministrators", ctx, subscriptionId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.ClassicAdministrator])
+	return ret0
+}
+
+// ListAzureClassicAdministrators indicates an expected call of ListAzureClassicAdministrators.
+func (mr *MockAzureClientMockRecorder) ListAzureClassicAdministrators(ctx, subscriptionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureClassicAdministrators", reflect.TypeOf((*MockAzureClient)(nil).ListAzureClassicAdministrators), ctx, subscriptionId)
+}
+
+// ListAzureCognitiveServicesAccounts mocks base method.
+func (m *MockAzureClient) ListAzureCognitiveServicesAccounts(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.CognitiveServicesAccount] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureCognitiveServicesAccounts", ctx, subscriptionId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.CognitiveServicesAccount])
+	return ret0
+}
+
+// ListAzureCognitiveServicesAccounts indicates an expected call of ListAzureCognitiveServicesAccounts.
+func (mr *MockAzureClientMockRecorder) ListAzureCognitiveServicesAccounts(ctx, subscriptionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureCognitiveServicesAccounts", reflect.TypeOf((*MockAzureClient)(nil).ListAzureCognitiveServicesAccounts), ctx, subscriptionId)
+}
+
 // ListAzureContainerRegistries mocks base method.
 func (m *MockAzureClient) ListAzureContainerRegistries(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.ContainerRegistry] {
 	m.ctrl.T.Helper()
@@ -296,6 +612,48 @@ func (mr *MockAzureClientMockRecorder) ListAzureContainerRegistries(ctx, subscri
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureContainerRegistries", reflect.TypeOf((*MockAzureClient)(nil).ListAzureContainerRegistries), ctx, subscriptionId)
 }
 
+// ListAzureContainerRegistryScopeMaps mocks base method.
+func (m *MockAzureClient) ListAzureContainerRegistryScopeMaps(ctx context.Context, containerRegistryId string) <-chan client.AzureResult[azure.ContainerRegistryScopeMap] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureContainerRegistryScopeMaps", ctx, containerRegistryId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.ContainerRegistryScopeMap])
+	return ret0
+}
+
+// ListAzureContainerRegistryScopeMaps indicates an expected call of ListAzureContainerRegistryScopeMaps.
+func (mr *MockAzureClientMockRecorder) ListAzureContainerRegistryScopeMaps(ctx, containerRegistryId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureContainerRegistryScopeMaps", reflect.TypeOf((*MockAzureClient)(nil).ListAzureContainerRegistryScopeMaps), ctx, containerRegistryId)
+}
+
+// ListAzureContainerRegistryTokens mocks base method.
+func (m *MockAzureClient) ListAzureContainerRegistryTokens(ctx context.Context, containerRegistryId string) <-chan client.AzureResult[azure.ContainerRegistryToken] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureContainerRegistryTokens", ctx, containerRegistryId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.ContainerRegistryToken])
+	return ret0
+}
+
+// ListAzureContainerRegistryTokens indicates an expected call of ListAzureContainerRegistryTokens.
+func (mr *MockAzureClientMockRecorder) ListAzureContainerRegistryTokens(ctx, containerRegistryId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureContainerRegistryTokens", reflect.TypeOf((*MockAzureClient)(nil).ListAzureContainerRegistryTokens), ctx, containerRegistryId)
+}
+
+// ListAzureContainerRegistryWebhooks mocks base method.
+func (m *MockAzureClient) ListAzureContainerRegistryWebhooks(ctx context.Context, containerRegistryId string) <-chan client.AzureResult[azure.ContainerRegistryWebhook] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureContainerRegistryWebhooks", ctx, containerRegistryId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.ContainerRegistryWebhook])
+	return ret0
+}
+
+// ListAzureContainerRegistryWebhooks indicates an expected call of ListAzureContainerRegistryWebhooks.
+func (mr *MockAzureClientMockRecorder) ListAzureContainerRegistryWebhooks(ctx, containerRegistryId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureContainerRegistryWebhooks", reflect.TypeOf((*MockAzureClient)(nil).ListAzureContainerRegistryWebhooks), ctx, containerRegistryId)
+}
+
 // ListAzureDeviceRegisteredOwners mocks base method.
 func (m *MockAzureClient) ListAzureDeviceRegisteredOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
 	m.ctrl.T.Helper()
@@ -338,6 +696,76 @@ func (mr *MockAzureClientMockRecorder) ListAzureFunctionApps(ctx, subscriptionId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureFunctionApps", reflect.TypeOf((*MockAzureClient)(nil).ListAzureFunctionApps), ctx, subscriptionId)
 }
 
+// ListAzureInvoiceSections mocks base method.
+func (m *MockAzureClient) ListAzureInvoiceSections(ctx context.Context, billingProfileId string) <-chan client.AzureResult[azure.InvoiceSection] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureInvoiceSections", ctx, billingProfileId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.InvoiceSection])
+	return ret0
+}
+
+// ListAzureInvoiceSections indicates an expected call of ListAzureInvoiceSections.
+func (mr *MockAzureClientMockRecorder) ListAzureInvoiceSections(ctx, billingProfileId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureInvoiceSections", reflect.TypeOf((*MockAzureClient)(nil).ListAzureInvoiceSections), ctx, billingProfileId)
+}
+
+// ListAzureJitNetworkAccessPolicies mocks base method.
+func (m *MockAzureClient) ListAzureJitNetworkAccessPolicies(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.JitNetworkAccessPolicy] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureJitNetworkAccessPolicies", ctx, subscriptionId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.JitNetworkAccessPolicy])
+	return ret0
+}
+
+// ListAzureJitNetworkAccessPolicies indicates an expected call of ListAzureJitNetworkAccessPolicies.
+func (mr *MockAzureClientMockRecorder) ListAzureJitNetworkAccessPolicies(ctx, subscriptionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureJitNetworkAccessPolicies", reflect.TypeOf((*MockAzureClient)(nil).ListAzureJitNetworkAccessPolicies), ctx, subscriptionId)
+}
+
+// ListAzureKeyVaultCertificates mocks base method.
+func (m *MockAzureClient) ListAzureKeyVaultCertificates(ctx context.Context, vaultUri string) <-chan client.AzureResult[azure.KeyVaultCertificate] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureKeyVaultCertificates", ctx, vaultUri)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.KeyVaultCertificate])
+	return ret0
+}
+
+// ListAzureKeyVaultCertificates indicates an expected call of ListAzureKeyVaultCertificates.
+func (mr *MockAzureClientMockRecorder) ListAzureKeyVaultCertificates(ctx, vaultUri any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureKeyVaultCertificates", reflect.TypeOf((*MockAzureClient)(nil).ListAzureKeyVaultCertificates), ctx, vaultUri)
+}
+
+// ListAzureKeyVaultKeys mocks base method.
+func (m *MockAzureClient) ListAzureKeyVaultKeys(ctx context.Context, vaultUri string) <-chan client.AzureResult[azure.KeyVaultKey] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureKeyVaultKeys", ctx, vaultUri)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.KeyVaultKey])
+	return ret0
+}
+
+// ListAzureKeyVaultKeys indicates an expected call of ListAzureKeyVaultKeys.
+func (mr *MockAzureClientMockRecorder) ListAzureKeyVaultKeys(ctx, vaultUri any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureKeyVaultKeys", reflect.TypeOf((*MockAzureClient)(nil).ListAzureKeyVaultKeys), ctx, vaultUri)
+}
+
+// ListAzureKeyVaultSecrets mocks base method.
+func (m *MockAzureClient) ListAzureKeyVaultSecrets(ctx context.Context, vaultUri string) <-chan client.AzureResult[azure.KeyVaultSecret] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureKeyVaultSecrets", ctx, vaultUri)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.KeyVaultSecret])
+	return ret0
+}
+
+// ListAzureKeyVaultSecrets indicates an expected call of ListAzureKeyVaultSecrets.
+func (mr *MockAzureClientMockRecorder) ListAzureKeyVaultSecrets(ctx, vaultUri any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureKeyVaultSecrets", reflect.TypeOf((*MockAzureClient)(nil).ListAzureKeyVaultSecrets), ctx, vaultUri)
+}
+
 // ListAzureKeyVaults mocks base method.
 func (m *MockAzureClient) ListAzureKeyVaults(ctx context.Context, subscriptionId string, params query.RMParams) <-chan client.AzureResult[azure.KeyVault] {
 	m.ctrl.T.Helper()
@@ -366,6 +794,34 @@ func (mr *MockAzureClientMockRecorder) ListAzureLogicApps(ctx, subscriptionId, f
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureLogicApps", reflect.TypeOf((*MockAzureClient)(nil).ListAzureLogicApps), ctx, subscriptionId, filter, top)
 }
 
+// ListAzureMachineLearningComputes mocks base method.
+func (m *MockAzureClient) ListAzureMachineLearningComputes(ctx context.Context, workspaceId string) <-chan client.AzureResult[azure.MachineLearningCompute] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureMachineLearningComputes", ctx, workspaceId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.MachineLearningCompute])
+	return ret0
+}
+
+// ListAzureMachineLearningComputes indicates an expected call of ListAzureMachineLearningComputes.
+func (mr *MockAzureClientMockRecorder) ListAzureMachineLearningComputes(ctx, workspaceId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureMachineLearningComputes", reflect.TypeOf((*MockAzureClient)(nil).ListAzureMachineLearningComputes), ctx, workspaceId)
+}
+
+// ListAzureMachineLearningWorkspaces mocks base method.
+func (m *MockAzureClient) ListAzureMachineLearningWorkspaces(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.MachineLearningWorkspace] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureMachineLearningWorkspaces", ctx, subscriptionId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.MachineLearningWorkspace])
+	return ret0
+}
+
+// ListAzureMachineLearningWorkspaces indicates an expected call of ListAzureMachineLearningWorkspaces.
+func (mr *MockAzureClientMockRecorder) ListAzureMachineLearningWorkspaces(ctx, subscriptionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureMachineLearningWorkspaces", reflect.TypeOf((*MockAzureClient)(nil).ListAzureMachineLearningWorkspaces), ctx, subscriptionId)
+}
+
 // ListAzureManagedClusters mocks base method.
 func (m *MockAzureClient) ListAzureManagedClusters(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.ManagedCluster] {
 	m.ctrl.T.Helper()
@@ -408,6 +864,90 @@ func (mr *MockAzureClientMockRecorder) ListAzureManagementGroups(ctx, skipToken
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureManagementGroups", reflect.TypeOf((*MockAzureClient)(nil).ListAzureManagementGroups), ctx, skipToken)
 }
 
+// ListAzureManagementLocks mocks base method.
+func (m *MockAzureClient) ListAzureManagementLocks(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.ManagementLock] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureManagementLocks", ctx, subscriptionId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.ManagementLock])
+	return ret0
+}
+
+// ListAzureManagementLocks indicates an expected call of ListAzureManagementLocks.
+func (mr *MockAzureClientMockRecorder) ListAzureManagementLocks(ctx, subscriptionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureManagementLocks", reflect.TypeOf((*MockAzureClient)(nil).ListAzureManagementLocks), ctx, subscriptionId)
+}
+
+// ListAzurePolicyAssignments mocks base method.
+func (m *MockAzureClient) ListAzurePolicyAssignments(ctx context.Context, scope string) <-chan client.AzureResult[azure.PolicyAssignment] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzurePolicyAssignments", ctx, scope)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.PolicyAssignment])
+	return ret0
+}
+
+// ListAzurePolicyAssignments indicates an expected call of ListAzurePolicyAssignments.
+func (mr *MockAzureClientMockRecorder) ListAzurePolicyAssignments(ctx, scope any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzurePolicyAssignments", reflect.TypeOf((*MockAzureClient)(nil).ListAzurePolicyAssignments), ctx, scope)
+}
+
+// ListAzurePolicyDefinitions mocks base method.
+func (m *MockAzureClient) ListAzurePolicyDefinitions(ctx context.Context, scope string) <-chan client.AzureResult[azure.PolicyDefinition] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzurePolicyDefinitions", ctx, scope)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.PolicyDefinition])
+	return ret0
+}
+
+// ListAzurePolicyDefinitions indicates an expected call of ListAzurePolicyDefinitions.
+func (mr *MockAzureClientMockRecorder) ListAzurePolicyDefinitions(ctx, scope any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzurePolicyDefinitions", reflect.TypeOf((*MockAzureClient)(nil).ListAzurePolicyDefinitions), ctx, scope)
+}
+
+// ListAzureRegistrationAssignments mocks base method.
+func (m *MockAzureClient) ListAzureRegistrationAssignments(ctx context.Context, scope string) <-chan client.AzureResult[azure.RegistrationAssignment] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureRegistrationAssignments", ctx, scope)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.RegistrationAssignment])
+	return ret0
+}
+
+// ListAzureRegistrationAssignments indicates an expected call of ListAzureRegistrationAssignments.
+func (mr *MockAzureClientMockRecorder) ListAzureRegistrationAssignments(ctx, scope any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureRegistrationAssignments", reflect.TypeOf((*MockAzureClient)(nil).ListAzureRegistrationAssignments), ctx, scope)
+}
+
+// ListAzureRegistrationDefinitions mocks base method.
+func (m *MockAzureClient) ListAzureRegistrationDefinitions(ctx context.Context, scope string) <-chan client.AzureResult[azure.RegistrationDefinition] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureRegistrationDefinitions", ctx, scope)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.RegistrationDefinition])
+	return ret0
+}
+
+// ListAzureRegistrationDefinitions indicates an expected call of ListAzureRegistrationDefinitions.
+func (mr *MockAzureClientMockRecorder) ListAzureRegistrationDefinitions(ctx, scope any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureRegistrationDefinitions", reflect.TypeOf((*MockAzureClient)(nil).ListAzureRegistrationDefinitions), ctx, scope)
+}
+
+// ListAzureResourceGraph mocks base method.
+func (m *MockAzureClient) ListAzureResourceGraph(ctx context.Context, kql string, subscriptionIds []string) <-chan client.AzureResult[json.RawMessage] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureResourceGraph", ctx, kql, subscriptionIds)
+	ret0, _ := ret[0].(<-chan client.AzureResult[json.RawMessage])
+	return ret0
+}
+
+// ListAzureResourceGraph indicates an expected call of ListAzureResourceGraph.
+func (mr *MockAzureClientMockRecorder) ListAzureResourceGraph(ctx, kql, subscriptionIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureResourceGraph", reflect.TypeOf((*MockAzureClient)(nil).ListAzureResourceGraph), ctx, kql, subscriptionIds)
+}
+
 // ListAzureResourceGroups mocks base method.
 func (m *MockAzureClient) ListAzureResourceGroups(ctx context.Context, subscriptionId string, params query.RMParams) <-chan client.AzureResult[azure.ResourceGroup] {
 	m.ctrl.T.Helper()
@@ -422,6 +962,34 @@ func (mr *MockAzureClientMockRecorder) ListAzureResourceGroups(ctx, subscription
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureResourceGroups", reflect.TypeOf((*MockAzureClient)(nil).ListAzureResourceGroups), ctx, subscriptionId, params)
 }
 
+// ListAzureResources mocks base method.
+func (m *MockAzureClient) ListAzureResources(ctx context.Context, subscriptionId string, params query.RMParams) <-chan client.AzureResult[azure.Resource] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureResources", ctx, subscriptionId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.Resource])
+	return ret0
+}
+
+// ListAzureResources indicates an expected call of ListAzureResources.
+func (mr *MockAzureClientMockRecorder) ListAzureResources(ctx, subscriptionId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureResources", reflect.TypeOf((*MockAzureClient)(nil).ListAzureResources), ctx, subscriptionId, params)
+}
+
+// ListAzureSitePublishingCredentialsPolicies mocks base method.
+func (m *MockAzureClient) ListAzureSitePublishingCredentialsPolicies(ctx context.Context, siteId string) <-chan client.AzureResult[azure.SitePublishingCredentialsPolicy] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureSitePublishingCredentialsPolicies", ctx, siteId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.SitePublishingCredentialsPolicy])
+	return ret0
+}
+
+// ListAzureSitePublishingCredentialsPolicies indicates an expected call of ListAzureSitePublishingCredentialsPolicies.
+func (mr *MockAzureClientMockRecorder) ListAzureSitePublishingCredentialsPolicies(ctx, siteId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureSitePublishingCredentialsPolicies", reflect.TypeOf((*MockAzureClient)(nil).ListAzureSitePublishingCredentialsPolicies), ctx, siteId)
+}
+
 // ListAzureStorageAccounts mocks base method.
 func (m *MockAzureClient) ListAzureStorageAccounts(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.StorageAccount] {
 	m.ctrl.T.Helper()
@@ -450,6 +1018,48 @@ func (mr *MockAzureClientMockRecorder) ListAzureStorageContainers(ctx, subscript
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureStorageContainers", reflect.TypeOf((*MockAzureClient)(nil).ListAzureStorageContainers), ctx, subscriptionId, resourceGroupName, saName, filter, includeDeleted, maxPageSize)
 }
 
+// ListAzureStorageFileShares mocks base method.
+func (m *MockAzureClient) ListAzureStorageFileShares(ctx context.Context, storageAccountId string) <-chan client.AzureResult[azure.StorageFileShare] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureStorageFileShares", ctx, storageAccountId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.StorageFileShare])
+	return ret0
+}
+
+// ListAzureStorageFileShares indicates an expected call of ListAzureStorageFileShares.
+func (mr *MockAzureClientMockRecorder) ListAzureStorageFileShares(ctx, storageAccountId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureStorageFileShares", reflect.TypeOf((*MockAzureClient)(nil).ListAzureStorageFileShares), ctx, storageAccountId)
+}
+
+// ListAzureStorageQueues mocks base method.
+func (m *MockAzureClient) ListAzureStorageQueues(ctx context.Context, storageAccountId string) <-chan client.AzureResult[azure.StorageQueue] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureStorageQueues", ctx, storageAccountId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.StorageQueue])
+	return ret0
+}
+
+// ListAzureStorageQueues indicates an expected call of ListAzureStorageQueues.
+func (mr *MockAzureClientMockRecorder) ListAzureStorageQueues(ctx, storageAccountId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureStorageQueues", reflect.TypeOf((*MockAzureClient)(nil).ListAzureStorageQueues), ctx, storageAccountId)
+}
+
+// ListAzureStorageTables mocks base method.
+func (m *MockAzureClient) ListAzureStorageTables(ctx context.Context, storageAccountId string) <-chan client.AzureResult[azure.StorageTable] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureStorageTables", ctx, storageAccountId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.StorageTable])
+	return ret0
+}
+
+// ListAzureStorageTables indicates an expected call of ListAzureStorageTables.
+func (mr *MockAzureClientMockRecorder) ListAzureStorageTables(ctx, storageAccountId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureStorageTables", reflect.TypeOf((*MockAzureClient)(nil).ListAzureStorageTables), ctx, storageAccountId)
+}
+
 // ListAzureSubscriptions mocks base method.
 func (m *MockAzureClient) ListAzureSubscriptions(ctx context.Context) <-chan client.AzureResult[azure.Subscription] {
 	m.ctrl.T.Helper()
@@ -520,6 +1130,34 @@ func (mr *MockAzureClientMockRecorder) ListAzureWebApps(ctx, subscriptionId any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureWebApps", reflect.TypeOf((*MockAzureClient)(nil).ListAzureWebApps), ctx, subscriptionId)
 }
 
+// ListKubernetesClusterRoleBindings mocks base method.
+func (m *MockAzureClient) ListKubernetesClusterRoleBindings(ctx context.Context, kubeconfig []byte) <-chan client.AzureResult[azure.KubernetesRoleBinding] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKubernetesClusterRoleBindings", ctx, kubeconfig)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.KubernetesRoleBinding])
+	return ret0
+}
+
+// ListKubernetesClusterRoleBindings indicates an expected call of ListKubernetesClusterRoleBindings.
+func (mr *MockAzureClientMockRecorder) ListKubernetesClusterRoleBindings(ctx, kubeconfig any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesClusterRoleBindings", reflect.TypeOf((*MockAzureClient)(nil).ListKubernetesClusterRoleBindings), ctx, kubeconfig)
+}
+
+// ListKubernetesRoleBindings mocks base method.
+func (m *MockAzureClient) ListKubernetesRoleBindings(ctx context.Context, kubeconfig []byte) <-chan client.AzureResult[azure.KubernetesRoleBinding] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKubernetesRoleBindings", ctx, kubeconfig)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.KubernetesRoleBinding])
+	return ret0
+}
+
+// ListKubernetesRoleBindings indicates an expected call of ListKubernetesRoleBindings.
+func (mr *MockAzureClientMockRecorder) ListKubernetesRoleBindings(ctx, kubeconfig any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesRoleBindings", reflect.TypeOf((*MockAzureClient)(nil).ListKubernetesRoleBindings), ctx, kubeconfig)
+}
+
 // ListRoleAssignmentPolicies mocks base method.
 func (m *MockAzureClient) ListRoleAssignmentPolicies(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.UnifiedRoleManagementPolicyAssignment] {
 	m.ctrl.T.Helper()
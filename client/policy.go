@@ -0,0 +1,90 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/client/rest"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzurePolicyAssignments https://learn.microsoft.com/en-us/rest/api/policy/policy-assignments/list?view=rest-policy-2023-04-01
+//
+// The scope may be a management group or subscription ID. Only assignments made at exactly that scope are listed.
+func (s *azureClient) ListAzurePolicyAssignments(ctx context.Context, scope string) <-chan AzureResult[azure.PolicyAssignment] {
+	var (
+		out    = make(chan AzureResult[azure.PolicyAssignment])
+		path   = fmt.Sprintf("%s/providers/Microsoft.Authorization/policyAssignments", scope)
+		params = query.RMParams{ApiVersion: "2023-04-01", Filter: "atExactScope()"}
+	)
+
+	go getAzureObjectList[azure.PolicyAssignment](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzurePolicyDefinitions https://learn.microsoft.com/en-us/rest/api/policy/policy-definitions/list?view=rest-policy-2023-04-01
+//
+// The scope may be a management group or subscription ID. Only definitions stored at exactly that scope are listed,
+// which excludes built-in definitions.
+func (s *azureClient) ListAzurePolicyDefinitions(ctx context.Context, scope string) <-chan AzureResult[azure.PolicyDefinition] {
+	var (
+		out    = make(chan AzureResult[azure.PolicyDefinition])
+		path   = fmt.Sprintf("%s/providers/Microsoft.Authorization/policyDefinitions", scope)
+		params = query.RMParams{ApiVersion: "2023-04-01", Filter: "atExactScope()"}
+	)
+
+	go getAzureObjectList[azure.PolicyDefinition](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// GetAzurePolicyDefinition https://learn.microsoft.com/en-us/rest/api/policy/policy-definitions/get?view=rest-policy-2023-04-01
+func (s *azureClient) GetAzurePolicyDefinition(ctx context.Context, policyDefinitionId string) (azure.PolicyDefinition, error) {
+	var (
+		params   = query.RMParams{ApiVersion: "2023-04-01"}
+		response azure.PolicyDefinition
+	)
+
+	if res, err := s.resourceManager.Get(ctx, policyDefinitionId, params, nil); err != nil {
+		return response, err
+	} else if err := rest.Decode(res.Body, &response); err != nil {
+		return response, err
+	} else {
+		return response, nil
+	}
+}
+
+// GetAzurePolicySetDefinition https://learn.microsoft.com/en-us/rest/api/policy/policy-set-definitions/get?view=rest-policy-2023-04-01
+func (s *azureClient) GetAzurePolicySetDefinition(ctx context.Context, policySetDefinitionId string) (azure.PolicySetDefinition, error) {
+	var (
+		params   = query.RMParams{ApiVersion: "2023-04-01"}
+		response azure.PolicySetDefinition
+	)
+
+	if res, err := s.resourceManager.Get(ctx, policySetDefinitionId, params, nil); err != nil {
+		return response, err
+	} else if err := rest.Decode(res.Body, &response); err != nil {
+		return response, err
+	} else {
+		return response, nil
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/client/rest"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+)
+
+const (
+	// Resource Graph returns at most 1000 rows per page
+	resourceGraphPageSize = 1000
+
+	// The number of subscriptions queried per request
+	resourceGraphSubscriptionBatchSize = 1000
+)
+
+// ListAzureResourceGraph https://learn.microsoft.com/en-us/rest/api/azureresourcegraph/resourcegraph/resources/resources
+func (s *azureClient) ListAzureResourceGraph(ctx context.Context, kql string, subscriptionIds []string) <-chan AzureResult[json.RawMessage] {
+	var (
+		out    = make(chan AzureResult[json.RawMessage])
+		path   = "/providers/Microsoft.ResourceGraph/resources"
+		params = query.RMParams{ApiVersion: "2022-10-01"}
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(out)
+
+		for start := 0; start < len(subscriptionIds); start += resourceGraphSubscriptionBatchSize {
+			end := min(start+resourceGraphSubscriptionBatchSize, len(subscriptionIds))
+			request := azure.ResourceGraphQueryRequest{
+				Subscriptions: subscriptionIds[start:end],
+				Query:         kql,
+				Options: azure.ResourceGraphQueryOptions{
+					ResultFormat: "objectArray",
+					Top:          resourceGraphPageSize,
+				},
+			}
+
+			for {
+				var response azure.ResourceGraphQueryResponse
+
+				pageCtx, pageCancel := context.WithTimeout(ctx, pageRequestTimeout)
+				if res, err := s.resourceManager.Post(pageCtx, path, request, params, nil); err != nil {
+					pageCancel()
+					_ = pipeline.Send(ctx.Done(), out, AzureResult[json.RawMessage]{Error: err})
+					return
+				} else if err := rest.Decode(res.Body, &response); err != nil {
+					pageCancel()
+					_ = pipeline.Send(ctx.Done(), out, AzureResult[json.RawMessage]{Error: err})
+					return
+				}
+				pageCancel()
+
+				for _, row := range response.Data {
+					if ok := pipeline.Send(ctx.Done(), out, AzureResult[json.RawMessage]{Ok: row}); !ok {
+						return
+					}
+				}
+
+				if response.SkipToken == "" {
+					break
+				}
+				request.Options.SkipToken = response.SkipToken
+			}
+		}
+	}()
+
+	return out
+}
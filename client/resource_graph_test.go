@@ -0,0 +1,69 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAzureResourceGraph_FollowsSkipToken(t *testing.T) {
+	var requests []azure.ResourceGraphQueryRequest
+	rm := &fakeRestClient{
+		postFunc: func(ctx context.Context, path string, body interface{}, params query.Params, headers map[string]string) (*http.Response, error) {
+			require.Equal(t, "/providers/Microsoft.ResourceGraph/resources", path)
+			request := body.(azure.ResourceGraphQueryRequest)
+			requests = append(requests, request)
+
+			response := `{"count": 1, "data": [{"id": "2"}]}`
+			if request.Options.SkipToken == "" {
+				response = `{"count": 1, "data": [{"id": "1"}], "$skipToken": "next"}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(response)),
+			}, nil
+		},
+	}
+	client := &azureClient{resourceManager: rm}
+
+	var rows []string
+	for item := range client.ListAzureResourceGraph(context.Background(), "resources", []string{"sub1", "sub2"}) {
+		require.NoError(t, item.Error)
+		var row struct {
+			Id string `json:"id"`
+		}
+		require.NoError(t, json.Unmarshal(item.Ok, &row))
+		rows = append(rows, row.Id)
+	}
+
+	require.Equal(t, []string{"1", "2"}, rows)
+	require.Len(t, requests, 2)
+	require.Equal(t, []string{"sub1", "sub2"}, requests[0].Subscriptions)
+	require.Equal(t, "resources", requests[1].Query)
+	require.Equal(t, "objectArray", requests[1].Options.ResultFormat)
+	require.Equal(t, "next", requests[1].Options.SkipToken)
+}
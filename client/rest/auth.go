@@ -19,10 +19,19 @@ package rest
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
 	"sync"
 	"time"
 
@@ -42,10 +51,17 @@ type AuthStrategy interface {
 	addAuthenticationToRequest(req *http.Request) (*http.Request, error)
 }
 
+// fallbackAuthStrategy is implemented by authentication strategies that have another way to authenticate when an auth
+// request fails
+type fallbackAuthStrategy interface {
+	fallback(err error) bool
+}
+
 // Authenticator manages the authentication process, using a specific AuthStrategy
 type Authenticator struct {
-	auth  AuthStrategy
-	mutex sync.RWMutex
+	auth    AuthStrategy
+	mutex   sync.RWMutex
+	refresh sync.Mutex
 }
 
 // ManagedIdentityAuthStrategy is an authentication strategy that uses Azure Managed Identity
@@ -81,6 +97,50 @@ type GenericAuthStrategy struct {
 	refreshToken  string
 	tenant        string
 	token         Token
+
+	clientAssertion func() (string, error)
+
+	// The client IDs a refresh token is redeemed as, starting with the one that last succeeded
+	refreshClientIds []string
+	refreshClient    int
+}
+
+// WorkloadIdentityAuthStrategy is an authentication strategy that exchanges a client assertion issued by another
+// identity provider, such as a Kubernetes service account token, for tokens of the application that trusts it. The
+// assertion is read from a file or the output of a command on every refresh since it is rotated by its issuer.
+type WorkloadIdentityAuthStrategy struct {
+	*GenericAuthStrategy
+	tokenFile string
+	command   string
+}
+
+// userAuthStrategy is the base of the authentication strategies that sign a user in interactively and then refresh their
+// tokens with the refresh token they were issued
+type userAuthStrategy struct {
+	api      url.URL
+	authUrl  url.URL
+	clientId string
+	tenant   string
+	http     *http.Client
+	login    *userLogin
+	prompt   io.Writer
+	signIn   func(ctx context.Context) error
+	token    Token
+	mutex    sync.RWMutex
+}
+
+// DeviceCodeAuthStrategy is an authentication strategy that signs a user in with the OAuth 2.0 device authorization
+// grant and then refreshes its tokens with the refresh token it was issued
+type DeviceCodeAuthStrategy struct {
+	*userAuthStrategy
+}
+
+// AuthCodeAuthStrategy is an authentication strategy that signs a user in through their web browser with the OAuth 2.0
+// authorization code grant and PKCE, receiving the code on a loopback listener, and then refreshes its tokens with the
+// refresh token it was issued
+type AuthCodeAuthStrategy struct {
+	*userAuthStrategy
+	openBrowser func(url string) error
 }
 
 // NewManagedIdentityAuthenticator creates a new Authenticator using the ManagedIdentityAuthStrategy
@@ -139,11 +199,98 @@ func NewGenericAuthenticator(config config.Config, auth *url.URL, api *url.URL)
 			refreshToken:  config.RefreshToken,
 			tenant:        config.Tenant,
 			token:         Token{},
+
+			refreshClientIds: refreshClientIds(config.RefreshClientId),
 		},
 		mutex: sync.RWMutex{},
 	}
 }
 
+// refreshClientIds returns the client IDs to redeem a refresh token as: the given one, if any, followed by the family
+// of first-party clients
+func refreshClientIds(clientId string) []string {
+	clientIds := []string{}
+	if clientId != "" {
+		clientIds = append(clientIds, clientId)
+	}
+	for _, familyClientId := range constants.FamilyClientIDs {
+		if !strings.EqualFold(familyClientId, clientId) {
+			clientIds = append(clientIds, familyClientId)
+		}
+	}
+	return clientIds
+}
+
+// NewWorkloadIdentityAuthenticator creates a new Authenticator using the WorkloadIdentityAuthStrategy
+func NewWorkloadIdentityAuthenticator(config config.Config, auth *url.URL, api *url.URL) *Authenticator {
+	strategy := &WorkloadIdentityAuthStrategy{
+		GenericAuthStrategy: &GenericAuthStrategy{
+			config:   config,
+			authUrl:  *auth,
+			api:      *api,
+			clientId: config.ApplicationId,
+			tenant:   config.Tenant,
+			token:    Token{},
+		},
+		tokenFile: config.FederatedTokenFile,
+		command:   config.AssertionCommand,
+	}
+	strategy.clientAssertion = strategy.readAssertion
+
+	return &Authenticator{
+		auth:  strategy,
+		mutex: sync.RWMutex{},
+	}
+}
+
+// NewDeviceCodeAuthenticator creates a new Authenticator using the DeviceCodeAuthStrategy
+func NewDeviceCodeAuthenticator(config config.Config, auth *url.URL, api *url.URL, http *http.Client) *Authenticator {
+	strategy := &DeviceCodeAuthStrategy{newUserAuthStrategy(config, auth, api, http)}
+	strategy.signIn = strategy.signInWithDeviceCode
+
+	return &Authenticator{
+		auth:  strategy,
+		mutex: sync.RWMutex{},
+	}
+}
+
+// NewAuthCodeAuthenticator creates a new Authenticator using the AuthCodeAuthStrategy
+func NewAuthCodeAuthenticator(config config.Config, auth *url.URL, api *url.URL, http *http.Client) *Authenticator {
+	strategy := &AuthCodeAuthStrategy{
+		userAuthStrategy: newUserAuthStrategy(config, auth, api, http),
+		openBrowser:      OpenBrowser,
+	}
+	strategy.signIn = strategy.signInWithBrowser
+
+	return &Authenticator{
+		auth:  strategy,
+		mutex: sync.RWMutex{},
+	}
+}
+
+func newUserAuthStrategy(config config.Config, auth *url.URL, api *url.URL, http *http.Client) *userAuthStrategy {
+	strategy := &userAuthStrategy{
+		api:      *api,
+		authUrl:  *auth,
+		clientId: config.PublicClientId,
+		tenant:   config.Tenant,
+		http:     http,
+		prompt:   os.Stderr,
+	}
+
+	if strategy.clientId == "" {
+		strategy.clientId = config.ApplicationId
+	}
+	if strategy.clientId == "" {
+		strategy.clientId = constants.AzPowerShellClientID
+	}
+	if strategy.tenant == "" {
+		strategy.tenant = "organizations"
+	}
+	strategy.login = sharedUserLogins.Get(auth.String(), strategy.tenant, strategy.clientId, config.RefreshToken)
+	return strategy
+}
+
 // Authenticate if needed and add authentication to the request
 func (s *Authenticator) AddAuthenticationToRequest(restClient *restClient, req *http.Request) (*http.Request, error) {
 
@@ -166,24 +313,35 @@ func (s *Authenticator) refreshIfExpired(r *restClient) error {
 	if !s.auth.isExpired() {
 		return nil
 	}
-	// Authenticate
-	authRequest, err := s.auth.createAuthRequest()
-	if err != nil {
-		return err
-	}
-	if authRequest == nil {
-		return nil
-	}
-	if authResponse, err := r.send(authRequest); err != nil {
-		return err
-	} else {
-		defer authResponse.Body.Close()
-		s.mutex.Lock()
-		defer s.mutex.Unlock()
 
-		if err := s.auth.decodeAuthResponse(authResponse); err != nil {
+	// Authenticate once for all of the requests that found the token expired
+	s.refresh.Lock()
+	defer s.refresh.Unlock()
+
+	for s.auth.isExpired() {
+		// Authenticate
+		authRequest, err := s.auth.createAuthRequest()
+		if err != nil {
 			return err
 		}
+		if authRequest == nil {
+			return nil
+		}
+		if authResponse, err := r.send(authRequest); err != nil {
+			if strategy, ok := s.auth.(fallbackAuthStrategy); ok && strategy.fallback(err) {
+				continue
+			}
+			return err
+		} else {
+			defer authResponse.Body.Close()
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+
+			if err := s.auth.decodeAuthResponse(authResponse); err != nil {
+				return err
+			}
+			return nil
+		}
 	}
 	return nil
 }
@@ -231,11 +389,9 @@ func (s *ManagedIdentityAuthStrategy) decodeAuthResponse(resp *http.Response) er
 
 func (s *GenericAuthStrategy) createAuthRequest() (*http.Request, error) {
 	var (
-		path         = url.URL{Path: fmt.Sprintf("/%s/oauth2/v2.0/token", s.tenant)}
-		endpoint     = s.authUrl.ResolveReference(&path)
-		defaultScope = url.URL{Path: "/.default"}
-		scope        = s.api.ResolveReference(&defaultScope)
-		body         = url.Values{}
+		path     = url.URL{Path: fmt.Sprintf("/%s/oauth2/v2.0/token", s.tenant)}
+		endpoint = s.authUrl.ResolveReference(&path)
+		body     = url.Values{}
 	)
 
 	if s.clientId == "" {
@@ -244,14 +400,22 @@ func (s *GenericAuthStrategy) createAuthRequest() (*http.Request, error) {
 		body.Add("client_id", s.clientId)
 	}
 
-	body.Add("scope", scope.ResolveReference(&defaultScope).String())
+	body.Add("scope", defaultScope(s.api))
 	if s.refreshToken != "" {
 		body.Add("grant_type", "refresh_token")
 		body.Add("refresh_token", s.refreshToken)
-		body.Set("client_id", constants.AzPowerShellClientID)
+		body.Set("client_id", s.refreshClientId())
 	} else if s.clientSecret != "" {
 		body.Add("grant_type", "client_credentials")
 		body.Add("client_secret", s.clientSecret)
+	} else if s.clientAssertion != nil {
+		if clientAssertion, err := s.clientAssertion(); err != nil {
+			return nil, err
+		} else {
+			body.Add("grant_type", "client_credentials")
+			body.Add("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+			body.Add("client_assertion", clientAssertion)
+		}
 	} else if s.clientCert != "" && s.clientKey != "" {
 		if clientAssertion, err := NewClientAssertion(endpoint.String(), s.clientId, s.clientCert, s.clientKey, s.clientKeyPass); err != nil {
 			return nil, err
@@ -276,6 +440,47 @@ func (s *GenericAuthStrategy) createAuthRequest() (*http.Request, error) {
 	}
 }
 
+func (s *GenericAuthStrategy) refreshClientId() string {
+	return s.refreshClientIds[s.refreshClient]
+}
+
+// fallback moves on to the next family client ID when a refresh token could not be redeemed as the current one for the
+// resource, e.g. because it was issued to a client that is not pre-authorized for it. It returns false for any other
+// error and once every client ID has been tried, starting over with the first one on the next refresh.
+func (s *GenericAuthStrategy) fallback(err error) bool {
+	if s.refreshToken == "" || !isClientRejected(err) {
+		return false
+	} else if s.refreshClient+1 >= len(s.refreshClientIds) {
+		s.refreshClient = 0
+		return false
+	} else {
+		s.refreshClient++
+		return true
+	}
+}
+
+// Token endpoint errors meaning that the client a refresh token was redeemed as can't get a token for the resource, see
+// https://learn.microsoft.com/en-us/entra/identity-platform/reference-error-codes
+var clientRejectedErrors = []string{
+	"unauthorized_client",
+	"invalid_client",
+	"AADSTS65001",  // The user or administrator has not consented to use the application
+	"AADSTS65002",  // Consent between first party application and first party resource must be configured via preauthorization
+	"AADSTS700016", // Application not found in the directory
+}
+
+func isClientRejected(err error) bool {
+	if err == nil {
+		return false
+	}
+	for _, code := range clientRejectedErrors {
+		if strings.Contains(err.Error(), code) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *GenericAuthStrategy) isExpired() bool {
 	return s.token.IsExpired()
 }
@@ -284,10 +489,23 @@ func (s *GenericAuthStrategy) isJWTProvided() bool {
 	return s.jwt != ""
 }
 
+// decodeAuthResponse stores the access token and, since refresh tokens are rotated on every use, the refresh token
+// issued with it, if any
 func (s *GenericAuthStrategy) decodeAuthResponse(resp *http.Response) error {
-	if err := json.NewDecoder(resp.Body).Decode(&s.token); err != nil {
+	var res struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+
+	if body, err := io.ReadAll(resp.Body); err != nil {
+		return err
+	} else if err := json.Unmarshal(body, &s.token); err != nil {
+		return err
+	} else if err := json.Unmarshal(body, &res); err != nil {
 		return err
 	} else {
+		if s.refreshToken != "" && res.RefreshToken != "" {
+			s.refreshToken = res.RefreshToken
+		}
 		return nil
 	}
 }
@@ -306,6 +524,36 @@ func (s *GenericAuthStrategy) addAuthenticationToRequest(req *http.Request) (*ht
 	return req, nil
 }
 
+// readAssertion reads the current client assertion from the token file or the output of the command
+func (s *WorkloadIdentityAuthStrategy) readAssertion() (string, error) {
+	var (
+		assertion []byte
+		err       error
+	)
+
+	if s.command != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), assertionCommandTimeout)
+		defer cancel()
+
+		if runtime.GOOS == "windows" {
+			assertion, err = exec.CommandContext(ctx, "cmd", "/C", s.command).Output()
+		} else {
+			assertion, err = exec.CommandContext(ctx, "sh", "-c", s.command).Output()
+		}
+		if err != nil {
+			return "", fmt.Errorf("unable to run the client assertion command: %w", err)
+		}
+	} else if assertion, err = os.ReadFile(s.tokenFile); err != nil {
+		return "", fmt.Errorf("unable to read the federated token file: %w", err)
+	}
+
+	if trimmed := strings.TrimSpace(string(assertion)); trimmed == "" {
+		return "", fmt.Errorf("the client assertion is empty")
+	} else {
+		return trimmed, nil
+	}
+}
+
 // Adds the access token to the outgoing HTTP request
 func (s *ManagedIdentitySDKAuthStrategy) addAuthenticationToRequest(req *http.Request) (*http.Request, error) {
 	token := s.token
@@ -350,3 +598,387 @@ func (s *ManagedIdentitySDKAuthStrategy) isJWTProvided() bool {
 	// Not used in SDK-based flow, do nothing
 	return false
 }
+
+// userLogins holds the refresh token of each interactive sign-in, shared by the clients of every API so that the user is
+// only asked to sign in once
+type userLogins struct {
+	mutex  sync.Mutex
+	logins map[string]*userLogin
+}
+
+var sharedUserLogins = userLogins{logins: make(map[string]*userLogin)}
+
+func (s *userLogins) Get(authority, tenant, clientId, refreshToken string) *userLogin {
+	key := strings.ToLower(strings.Join([]string{authority, tenant, clientId}, "|"))
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if login, ok := s.logins[key]; ok {
+		return login
+	} else {
+		login := &userLogin{refreshToken: refreshToken}
+		s.logins[key] = login
+		return login
+	}
+}
+
+// userLogin is the outcome of a single interactive sign-in
+type userLogin struct {
+	mutex        sync.Mutex
+	refreshToken string
+}
+
+// setRefreshToken keeps the refresh token of the latest token response. Refresh tokens are rotated on every use, so
+// the previous one is kept when none was issued.
+func (s *userLogin) setRefreshToken(refreshToken string) {
+	if refreshToken != "" {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.refreshToken = refreshToken
+	}
+}
+
+// deviceCodeResponse is mapped according to https://learn.microsoft.com/en-us/entra/identity-platform/v2-oauth2-device-code#device-authorization-response
+type deviceCodeResponse struct {
+	DeviceCode      string         `json:"device_code"`
+	UserCode        string         `json:"user_code"`
+	VerificationUri string         `json:"verification_uri"`
+	ExpiresIn       IntOrStringInt `json:"expires_in"`
+	Interval        IntOrStringInt `json:"interval"`
+	Message         string         `json:"message"`
+}
+
+// tokenErrorResponse is mapped according to https://learn.microsoft.com/en-us/entra/identity-platform/v2-oauth2-device-code#expected-errors
+type tokenErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+const (
+	deviceCodeGrantType        = "urn:ietf:params:oauth:grant-type:device_code"
+	defaultDeviceCodeInterval  = 5 * time.Second
+	defaultDeviceCodeExpiry    = 15 * time.Minute
+	deviceCodeSlowDownInterval = 5 * time.Second
+	browserSignInTimeout       = 5 * time.Minute
+	assertionCommandTimeout    = time.Minute
+)
+
+func (s *userAuthStrategy) isExpired() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.token.IsExpired()
+}
+
+func (s *userAuthStrategy) isJWTProvided() bool {
+	return false
+}
+
+// createAuthRequest signs the user in the first time and returns a refresh token request after that. No request is
+// returned when the token was issued by the sign-in itself.
+func (s *userAuthStrategy) createAuthRequest() (*http.Request, error) {
+	s.login.mutex.Lock()
+	defer s.login.mutex.Unlock()
+
+	// Another request may have signed in while this one was waiting
+	if !s.isExpired() {
+		return nil, nil
+	} else if s.login.refreshToken == "" {
+		return nil, s.signIn(context.Background())
+	}
+
+	body := url.Values{}
+	body.Add("client_id", s.clientId)
+	body.Add("scope", s.scope())
+	body.Add("grant_type", "refresh_token")
+	body.Add("refresh_token", s.login.refreshToken)
+
+	return NewRequest(context.Background(), "POST", s.endpoint("token"), body, nil, nil)
+}
+
+func (s *userAuthStrategy) decodeAuthResponse(resp *http.Response) error {
+	if body, err := io.ReadAll(resp.Body); err != nil {
+		return err
+	} else if refreshToken, err := s.setToken(body); err != nil {
+		return err
+	} else {
+		s.login.setRefreshToken(refreshToken)
+		return nil
+	}
+}
+
+func (s *userAuthStrategy) addAuthenticationToRequest(req *http.Request) (*http.Request, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	req.Header.Set("Authorization", s.token.String())
+	return req, nil
+}
+
+// redeem requests a token for a grant obtained by signing in. The caller holds the lock of the login.
+func (s *userAuthStrategy) redeem(ctx context.Context, body url.Values) (*tokenErrorResponse, error) {
+	body.Set("client_id", s.clientId)
+
+	req, err := NewRequest(ctx, "POST", s.endpoint("token"), body, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		var errRes tokenErrorResponse
+		if err := Decode(res.Body, &errRes); err != nil {
+			return nil, fmt.Errorf("malformed error response, status code: %d", res.StatusCode)
+		} else {
+			return &errRes, nil
+		}
+	} else {
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, err
+		} else if refreshToken, err := s.setToken(body); err != nil {
+			return nil, err
+		} else {
+			if refreshToken != "" {
+				s.login.refreshToken = refreshToken
+			}
+			return nil, nil
+		}
+	}
+}
+
+// setToken stores the access token of a token response and returns the refresh token issued with it, if any.
+func (s *userAuthStrategy) setToken(body []byte) (string, error) {
+	var (
+		token Token
+		res   struct {
+			RefreshToken string `json:"refresh_token"`
+		}
+	)
+
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", err
+	} else if err := json.Unmarshal(body, &res); err != nil {
+		return "", err
+	} else {
+		s.mutex.Lock()
+		s.token = token
+		s.mutex.Unlock()
+
+		return res.RefreshToken, nil
+	}
+}
+
+func (s *userAuthStrategy) endpoint(name string) *url.URL {
+	path := url.URL{Path: fmt.Sprintf("/%s/oauth2/v2.0/%s", s.tenant, name)}
+	return s.authUrl.ResolveReference(&path)
+}
+
+// scope requests a refresh token along with access to the API
+func (s *userAuthStrategy) scope() string {
+	return defaultScope(s.api) + " offline_access"
+}
+
+// defaultScope requests the permissions granted to the client for the API, which is identified by its url or, for
+// APIs without one such as AKS API servers, by its application ID
+func defaultScope(api url.URL) string {
+	if api.Scheme == "" {
+		return api.String() + "/.default"
+	} else {
+		defaultScope := url.URL{Path: "/.default"}
+		return api.ResolveReference(&defaultScope).String()
+	}
+}
+
+// signInWithDeviceCode asks the user to enter a code on the verification page and polls the token endpoint until they
+// have done so
+func (s *DeviceCodeAuthStrategy) signInWithDeviceCode(ctx context.Context) error {
+	code, err := s.requestDeviceCode(ctx)
+	if err != nil {
+		return err
+	} else if code.Message != "" {
+		fmt.Fprintln(s.prompt, code.Message)
+	} else {
+		fmt.Fprintf(s.prompt, "To sign in, use a web browser to open the page %s and enter the code %s to authenticate.\n", code.VerificationUri, code.UserCode)
+	}
+
+	interval := time.Duration(code.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultDeviceCodeInterval
+	}
+
+	expiresIn := time.Duration(code.ExpiresIn) * time.Second
+	if expiresIn <= 0 {
+		expiresIn = defaultDeviceCodeExpiry
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, expiresIn)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("device code sign-in was not completed in time: %w", ctx.Err())
+		case <-time.After(interval):
+		}
+
+		body := url.Values{}
+		body.Add("grant_type", deviceCodeGrantType)
+		body.Add("device_code", code.DeviceCode)
+
+		if errRes, err := s.redeem(ctx, body); err != nil {
+			return err
+		} else if errRes == nil {
+			return nil
+		} else if errRes.Error == "slow_down" {
+			interval += deviceCodeSlowDownInterval
+		} else if errRes.Error != "authorization_pending" {
+			return fmt.Errorf("device code sign-in failed: %s: %s", errRes.Error, errRes.ErrorDescription)
+		}
+	}
+}
+
+// requestDeviceCode starts a device code sign-in, returning the code the user is asked to enter
+func (s *DeviceCodeAuthStrategy) requestDeviceCode(ctx context.Context) (deviceCodeResponse, error) {
+	var (
+		code = deviceCodeResponse{}
+		body = url.Values{}
+	)
+	body.Add("client_id", s.clientId)
+	body.Add("scope", s.scope())
+
+	req, err := NewRequest(ctx, "POST", s.endpoint("devicecode"), body, nil, nil)
+	if err != nil {
+		return code, err
+	}
+
+	res, err := s.http.Do(req)
+	if err != nil {
+		return code, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return code, decodeTokenError(res)
+	} else {
+		return code, Decode(res.Body, &code)
+	}
+}
+
+// signInWithBrowser opens the authorization endpoint in the user's web browser and redeems the code it redirects to a
+// loopback listener, see https://learn.microsoft.com/en-us/entra/identity-platform/v2-oauth2-auth-code-flow
+func (s *AuthCodeAuthStrategy) signInWithBrowser(ctx context.Context) error {
+	// Entra ID ignores the port of loopback redirect URIs, so any free one can be used. The redirect URI names the
+	// address the listener is bound to, as localhost may resolve to another loopback address such as ::1.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return fmt.Errorf("unable to start the loopback listener: %w", err)
+	}
+
+	var (
+		redirectUri = fmt.Sprintf("http://127.0.0.1:%d/", listener.Addr().(*net.TCPAddr).Port)
+		verifier    = randomString(32)
+		state       = randomString(16)
+		challenge   = sha256.Sum256([]byte(verifier))
+		callbacks   = make(chan url.Values, 1)
+		server      = &http.Server{Handler: authCodeCallback(callbacks)}
+		authorize   = s.endpoint("authorize")
+		query       = url.Values{}
+	)
+	go server.Serve(listener)
+	defer server.Close()
+
+	query.Add("client_id", s.clientId)
+	query.Add("response_type", "code")
+	query.Add("response_mode", "query")
+	query.Add("redirect_uri", redirectUri)
+	query.Add("scope", s.scope())
+	query.Add("state", state)
+	query.Add("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	query.Add("code_challenge_method", "S256")
+	query.Add("prompt", "select_account")
+	authorize.RawQuery = query.Encode()
+
+	fmt.Fprintf(s.prompt, "To sign in, complete the login that was opened in your web browser. If it did not open, visit %s\n", authorize)
+	if err := s.openBrowser(authorize.String()); err != nil {
+		fmt.Fprintf(s.prompt, "unable to open a web browser: %v\n", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, browserSignInTimeout)
+	defer cancel()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("browser sign-in was not completed in time: %w", ctx.Err())
+	case callback := <-callbacks:
+		if callback.Get("state") != state {
+			return fmt.Errorf("browser sign-in failed: the state of the redirect does not match the request")
+		} else if callback.Get("error") != "" {
+			return fmt.Errorf("browser sign-in failed: %s: %s", callback.Get("error"), callback.Get("error_description"))
+		}
+
+		body := url.Values{}
+		body.Add("grant_type", "authorization_code")
+		body.Add("code", callback.Get("code"))
+		body.Add("redirect_uri", redirectUri)
+		body.Add("code_verifier", verifier)
+		body.Add("scope", s.scope())
+
+		if errRes, err := s.redeem(ctx, body); err != nil {
+			return err
+		} else if errRes != nil {
+			return fmt.Errorf("browser sign-in failed: %s: %s", errRes.Error, errRes.ErrorDescription)
+		} else {
+			return nil
+		}
+	}
+}
+
+// authCodeCallback handles the redirect of the authorization endpoint, passing on the parameters of the first one
+func authCodeCallback(callbacks chan<- url.Values) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+
+		select {
+		case callbacks <- r.URL.Query():
+			fmt.Fprintln(w, "Authentication complete. You can close this window and return to AzureHound.")
+		default:
+			http.Error(w, "authentication has already completed", http.StatusConflict)
+		}
+	})
+}
+
+// OpenBrowser opens the given url in the default web browser of the system
+func OpenBrowser(url string) error {
+	switch runtime.GOOS {
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", url).Start()
+	case "darwin":
+		return exec.Command("open", url).Start()
+	default:
+		return exec.Command("xdg-open", url).Start()
+	}
+}
+
+// randomString returns the url-safe encoding of the given number of random bytes, as used for PKCE code verifiers
+func randomString(size int) string {
+	bytes := make([]byte, size)
+	rand.Read(bytes)
+	return base64.RawURLEncoding.EncodeToString(bytes)
+}
+
+func decodeTokenError(res *http.Response) error {
+	var errRes tokenErrorResponse
+	if err := Decode(res.Body, &errRes); err != nil {
+		return fmt.Errorf("malformed error response, status code: %d", res.StatusCode)
+	} else {
+		return fmt.Errorf("%s: %s", errRes.Error, errRes.ErrorDescription)
+	}
+}
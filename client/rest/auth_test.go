@@ -0,0 +1,421 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+package rest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client/config"
+	"github.com/bloodhoundad/azurehound/v2/constants"
+)
+
+func TestDeviceCodeAuthenticator(t *testing.T) {
+	var (
+		mutex    sync.Mutex
+		polls    int
+		grants   []string
+		scopes   []string
+		response = func(w http.ResponseWriter, v any) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(v)
+		}
+	)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		r.ParseForm()
+		switch r.URL.Path {
+		case "/tenant/oauth2/v2.0/devicecode":
+			scopes = append(scopes, r.PostForm.Get("scope"))
+			response(w, map[string]any{
+				"device_code": "device-code",
+				"user_code":   "USERCODE",
+				"expires_in":  900,
+				"interval":    1,
+				"message":     "enter USERCODE",
+			})
+		case "/tenant/oauth2/v2.0/token":
+			grants = append(grants, r.PostForm.Get("grant_type"))
+			if r.PostForm.Get("grant_type") == deviceCodeGrantType {
+				if polls++; polls == 1 {
+					w.WriteHeader(http.StatusBadRequest)
+					response(w, map[string]string{"error": "authorization_pending"})
+					return
+				}
+				response(w, map[string]any{"access_token": "graph", "expires_in": 3600, "refresh_token": "refresh-1"})
+			} else if r.PostForm.Get("refresh_token") == "refresh-1" {
+				scopes = append(scopes, r.PostForm.Get("scope"))
+				response(w, map[string]any{"access_token": "arm", "expires_in": 3600, "refresh_token": "refresh-2"})
+			} else {
+				w.WriteHeader(http.StatusBadRequest)
+				response(w, map[string]string{"error": "invalid_grant"})
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer testServer.Close()
+
+	var (
+		prompt           = bytes.Buffer{}
+		cfg              = config.Config{Authority: testServer.URL, Tenant: "tenant", ApplicationId: "device-code-test", DeviceCode: true}
+		newAuthenticated = func(api string) *http.Request {
+			client, err := NewRestClient(api, cfg)
+			if err != nil {
+				t.Fatalf("error initializing rest client %v", err)
+			}
+			client.(*restClient).Authenticator.auth.(*DeviceCodeAuthStrategy).prompt = &prompt
+
+			req, err := client.AddAuthenticationToRequest(httptest.NewRequest(http.MethodGet, api, nil))
+			if err != nil {
+				t.Fatalf("error authenticating request %v", err)
+			}
+			return req
+		}
+	)
+
+	if req := newAuthenticated("https://graph.microsoft.com"); req.Header.Get("Authorization") != "Bearer graph" {
+		t.Errorf("got %q; want the token of the device code sign-in", req.Header.Get("Authorization"))
+	} else if !strings.Contains(prompt.String(), "enter USERCODE") {
+		t.Errorf("expected the user code to be printed, got %q", prompt.String())
+	}
+
+	// The sign-in is shared with the clients of other APIs, which only need to redeem its refresh token
+	if req := newAuthenticated("https://management.azure.com"); req.Header.Get("Authorization") != "Bearer arm" {
+		t.Errorf("got %q; want the token issued for the refresh token", req.Header.Get("Authorization"))
+	}
+
+	expectedGrants := []string{deviceCodeGrantType, deviceCodeGrantType, "refresh_token"}
+	if strings.Join(grants, ",") != strings.Join(expectedGrants, ",") {
+		t.Errorf("got grants %v; want %v", grants, expectedGrants)
+	}
+
+	expectedScopes := []string{"https://graph.microsoft.com/.default offline_access", "https://management.azure.com/.default offline_access"}
+	if strings.Join(scopes, ",") != strings.Join(expectedScopes, ",") {
+		t.Errorf("got scopes %v; want %v", scopes, expectedScopes)
+	}
+
+	login := sharedUserLogins.Get(testServer.URL, "tenant", "device-code-test", "")
+	if login.refreshToken != "refresh-2" {
+		t.Errorf("got refresh token %q; want the rotated refresh-2", login.refreshToken)
+	}
+}
+
+func TestAuthCodeAuthenticator(t *testing.T) {
+	var (
+		mutex       sync.Mutex
+		challenge   string
+		redirectUri string
+		response    = func(w http.ResponseWriter, v any) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(v)
+		}
+	)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		r.ParseForm()
+		switch r.URL.Path {
+		case "/tenant/oauth2/v2.0/authorize":
+			if r.Form.Get("code_challenge_method") != "S256" || r.Form.Get("client_id") != "public-client" {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			challenge = r.Form.Get("code_challenge")
+			redirectUri = r.Form.Get("redirect_uri")
+
+			// Stand in for the user signing in and consenting
+			redirect, _ := url.Parse(redirectUri)
+			redirect.RawQuery = url.Values{"code": {"auth-code"}, "state": {r.Form.Get("state")}}.Encode()
+			http.Redirect(w, r, redirect.String(), http.StatusFound)
+		case "/tenant/oauth2/v2.0/token":
+			verifier := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+			if r.PostForm.Get("grant_type") != "authorization_code" || r.PostForm.Get("code") != "auth-code" {
+				w.WriteHeader(http.StatusBadRequest)
+				response(w, map[string]string{"error": "invalid_grant"})
+			} else if base64.RawURLEncoding.EncodeToString(verifier[:]) != challenge || r.PostForm.Get("redirect_uri") != redirectUri {
+				w.WriteHeader(http.StatusBadRequest)
+				response(w, map[string]string{"error": "invalid_grant", "error_description": "PKCE verification failed"})
+			} else {
+				response(w, map[string]any{"access_token": "graph", "expires_in": 3600, "refresh_token": "refresh"})
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer testServer.Close()
+
+	var (
+		cfg       = config.Config{Authority: testServer.URL, Tenant: "tenant", PublicClientId: "public-client", BrowserLogin: true}
+		completed = make(chan string, 1)
+	)
+
+	client, err := NewRestClient("https://graph.microsoft.com", cfg)
+	if err != nil {
+		t.Fatalf("error initializing rest client %v", err)
+	}
+
+	strategy := client.(*restClient).Authenticator.auth.(*AuthCodeAuthStrategy)
+	strategy.prompt = io.Discard
+	strategy.openBrowser = func(url string) error {
+		// Stand in for the browser, following the redirect to the loopback listener
+		go func() {
+			if res, err := http.Get(url); err != nil {
+				completed <- err.Error()
+			} else {
+				body, _ := io.ReadAll(res.Body)
+				res.Body.Close()
+				completed <- string(body)
+			}
+		}()
+		return nil
+	}
+
+	if req, err := client.AddAuthenticationToRequest(httptest.NewRequest(http.MethodGet, "https://graph.microsoft.com", nil)); err != nil {
+		t.Fatalf("error authenticating request %v", err)
+	} else if req.Header.Get("Authorization") != "Bearer graph" {
+		t.Errorf("got %q; want the token redeemed for the authorization code", req.Header.Get("Authorization"))
+	} else if page := <-completed; !strings.Contains(page, "Authentication complete") {
+		t.Errorf("expected the browser to be told the sign-in completed, got %q", page)
+	} else if !strings.HasPrefix(redirectUri, "http://127.0.0.1:") {
+		t.Errorf("expected a loopback redirect uri, got %q", redirectUri)
+	} else if login := sharedUserLogins.Get(testServer.URL, "tenant", "public-client", ""); login.refreshToken != "refresh" {
+		t.Errorf("got refresh token %q; want it to be kept for the run", login.refreshToken)
+	}
+}
+
+func TestWorkloadIdentityAuthenticator(t *testing.T) {
+	var (
+		mutex      sync.Mutex
+		assertions []string
+	)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		r.ParseForm()
+		if r.URL.Path != "/tenant/oauth2/v2.0/token" {
+			w.WriteHeader(http.StatusNotFound)
+		} else if r.PostForm.Get("client_assertion_type") != "urn:ietf:params:oauth:client-assertion-type:jwt-bearer" || r.PostForm.Get("client_id") != "workload-app" {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			assertions = append(assertions, r.PostForm.Get("client_assertion"))
+			w.Header().Set("Content-Type", "application/json")
+			// Expire immediately so every request refreshes
+			json.NewEncoder(w).Encode(map[string]any{"access_token": r.PostForm.Get("client_assertion"), "expires_in": 0})
+		}
+	}))
+	defer testServer.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	authenticate := func(cfg config.Config) string {
+		client, err := NewRestClient("https://graph.microsoft.com", cfg)
+		if err != nil {
+			t.Fatalf("error initializing rest client %v", err)
+		}
+
+		req, err := client.AddAuthenticationToRequest(httptest.NewRequest(http.MethodGet, "https://graph.microsoft.com", nil))
+		if err != nil {
+			t.Fatalf("error authenticating request %v", err)
+		}
+		return req.Header.Get("Authorization")
+	}
+
+	cfg := config.Config{Authority: testServer.URL, Tenant: "tenant", ApplicationId: "workload-app", FederatedTokenFile: tokenFile}
+	client, err := NewRestClient("https://graph.microsoft.com", cfg)
+	if err != nil {
+		t.Fatalf("error initializing rest client %v", err)
+	}
+
+	// The token file is read again on every refresh, picking up the rotated assertion
+	for _, assertion := range []string{"assertion-1", "assertion-2"} {
+		if err := os.WriteFile(tokenFile, []byte(assertion+"\n"), 0600); err != nil {
+			t.Fatal(err)
+		} else if req, err := client.AddAuthenticationToRequest(httptest.NewRequest(http.MethodGet, "https://graph.microsoft.com", nil)); err != nil {
+			t.Fatalf("error authenticating request %v", err)
+		} else if req.Header.Get("Authorization") != "Bearer "+assertion {
+			t.Errorf("got %q; want the token issued for %s", req.Header.Get("Authorization"), assertion)
+		}
+	}
+
+	if runtime.GOOS != "windows" {
+		cfg = config.Config{Authority: testServer.URL, Tenant: "tenant", ApplicationId: "workload-app", AssertionCommand: "echo assertion-3"}
+		if authorization := authenticate(cfg); authorization != "Bearer assertion-3" {
+			t.Errorf("got %q; want the token issued for the output of the command", authorization)
+		}
+	}
+
+	if _, err := NewRestClient("https://graph.microsoft.com", config.Config{FederatedTokenFile: tokenFile}); err == nil {
+		t.Errorf("expected an error without the client id of the application")
+	}
+
+	// A token file set by the workload identity webhook does not override an explicit credential
+	cfg = config.Config{Authority: testServer.URL, Tenant: "tenant", ApplicationId: "app", ClientSecret: "secret", FederatedTokenFile: tokenFile}
+	if client, err := NewRestClient("https://graph.microsoft.com", cfg); err != nil {
+		t.Fatalf("error initializing rest client %v", err)
+	} else if strategy, ok := client.(*restClient).Authenticator.auth.(*GenericAuthStrategy); !ok {
+		t.Errorf("got %T; want %T", client.(*restClient).Authenticator.auth, strategy)
+	}
+}
+
+func TestRefreshTokenFamilyFallback(t *testing.T) {
+	var (
+		mutex         sync.Mutex
+		clientIds     []string
+		refreshTokens []string
+	)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		r.ParseForm()
+		clientIds = append(clientIds, r.PostForm.Get("client_id"))
+		w.Header().Set("Content-Type", "application/json")
+
+		// Only the Azure CLI is pre-authorized for the resource in this stand-in
+		if r.PostForm.Get("grant_type") != "refresh_token" || r.PostForm.Get("client_id") != constants.AzCLIClientID {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant", "error_description": "AADSTS65001: The user or administrator has not consented to use the application"})
+		} else {
+			refreshTokens = append(refreshTokens, r.PostForm.Get("refresh_token"))
+			json.NewEncoder(w).Encode(map[string]any{"access_token": "token", "expires_in": 0, "refresh_token": "rotated-refresh-token"})
+		}
+	}))
+	defer testServer.Close()
+
+	cfg := config.Config{Authority: testServer.URL, Tenant: "tenant", RefreshToken: "family-refresh-token", RefreshClientId: "issuing-client"}
+	client, err := NewRestClient("https://management.azure.com", cfg)
+	if err != nil {
+		t.Fatalf("error initializing rest client %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if req, err := client.AddAuthenticationToRequest(httptest.NewRequest(http.MethodGet, "https://management.azure.com", nil)); err != nil {
+			t.Fatalf("error authenticating request %v", err)
+		} else if req.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("got %q; want the token redeemed by a family client", req.Header.Get("Authorization"))
+		}
+	}
+
+	// The client that succeeded is used again for the next refresh
+	expected := []string{"issuing-client", constants.AzPowerShellClientID, constants.AzCLIClientID, constants.AzCLIClientID}
+	if strings.Join(clientIds, ",") != strings.Join(expected, ",") {
+		t.Errorf("got client ids %v; want %v", clientIds, expected)
+	}
+
+	// The refresh token rotated by the first refresh is redeemed by the next one
+	if expected := []string{"family-refresh-token", "rotated-refresh-token"}; strings.Join(refreshTokens, ",") != strings.Join(expected, ",") {
+		t.Errorf("got refresh tokens %v; want %v", refreshTokens, expected)
+	}
+}
+
+func TestRefreshTokenNoFallbackOnOtherErrors(t *testing.T) {
+	var (
+		mutex     sync.Mutex
+		clientIds []string
+	)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		r.ParseForm()
+		clientIds = append(clientIds, r.PostForm.Get("client_id"))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant", "error_description": "AADSTS70008: The refresh token has expired due to inactivity"})
+	}))
+	defer testServer.Close()
+
+	cfg := config.Config{Authority: testServer.URL, Tenant: "tenant", RefreshToken: "expired-refresh-token", RefreshClientId: "issuing-client"}
+	client, err := NewRestClient("https://management.azure.com", cfg)
+	if err != nil {
+		t.Fatalf("error initializing rest client %v", err)
+	}
+
+	if _, err := client.AddAuthenticationToRequest(httptest.NewRequest(http.MethodGet, "https://management.azure.com", nil)); err == nil || !strings.Contains(err.Error(), "AADSTS70008") {
+		t.Errorf("got error %v; want the error of the token endpoint", err)
+	}
+	if expected := []string{"issuing-client"}; strings.Join(clientIds, ",") != strings.Join(expected, ",") {
+		t.Errorf("got client ids %v; want %v", clientIds, expected)
+	}
+}
+
+func TestSeparateJWTs(t *testing.T) {
+	jwt := func(aud string) string {
+		claims, _ := json.Marshal(map[string]string{"aud": aud})
+		return "e30." + base64.RawURLEncoding.EncodeToString(claims) + ".signature"
+	}
+
+	var (
+		graphJWT = jwt("https://graph.microsoft.com")
+		mgmtJWT  = jwt("https://management.azure.com/")
+		cfg      = config.Config{Region: constants.Cloud, GraphJWT: graphJWT, MgmtJWT: mgmtJWT}
+	)
+
+	for api, expected := range map[string]string{cfg.GraphUrl(): graphJWT, cfg.ResourceManagerUrl(): mgmtJWT} {
+		if client, err := NewRestClient(api, cfg); err != nil {
+			t.Fatalf("error initializing rest client %v", err)
+		} else if req, err := client.AddAuthenticationToRequest(httptest.NewRequest(http.MethodGet, api, nil)); err != nil {
+			t.Errorf("error authenticating request to %s: %v", api, err)
+		} else if req.Header.Get("Authorization") != "Bearer "+expected {
+			t.Errorf("got %q for %s; want its own JWT", req.Header.Get("Authorization"), api)
+		}
+	}
+}
+
+func TestApplicationIdScope(t *testing.T) {
+	var scope string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		scope = r.PostForm.Get("scope")
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"access_token": "token", "expires_in": 3600})
+	}))
+	defer testServer.Close()
+
+	cfg := config.Config{Authority: testServer.URL, Tenant: "tenant", ApplicationId: "client", ClientSecret: "secret"}
+	client, err := NewRestClient(constants.AKSServerAppID, cfg)
+	if err != nil {
+		t.Fatalf("error initializing rest client %v", err)
+	}
+
+	if _, err := client.AddAuthenticationToRequest(httptest.NewRequest(http.MethodGet, "https://cluster.hcp.eastus.azmk8s.io", nil)); err != nil {
+		t.Fatalf("error authenticating request %v", err)
+	} else if expected := constants.AKSServerAppID + "/.default"; scope != expected {
+		t.Errorf("got scope %q; want %q", scope, expected)
+	}
+}
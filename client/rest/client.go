@@ -26,8 +26,6 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"strconv"
-	"time"
 
 	"github.com/bloodhoundad/azurehound/v2/client/config"
 	"github.com/bloodhoundad/azurehound/v2/client/query"
@@ -45,6 +43,7 @@ type RestClient interface {
 }
 
 func NewRestClient(apiUrl string, config config.Config) (RestClient, error) {
+	config.JWT = config.JWTFor(apiUrl)
 
 	if auth, err := url.Parse(config.AuthorityUrl()); err != nil {
 		return nil, err
@@ -61,6 +60,15 @@ func NewRestClient(apiUrl string, config config.Config) (RestClient, error) {
 			}
 			authenticator = NewManagedIdentitySDKAuthenticator(config, api, cred)
 
+		} else if config.DeviceCode && config.JWT == "" {
+			authenticator = NewDeviceCodeAuthenticator(config, auth, api, http)
+		} else if config.BrowserLogin && config.JWT == "" {
+			authenticator = NewAuthCodeAuthenticator(config, auth, api, http)
+		} else if (config.FederatedTokenFile != "" || config.AssertionCommand != "") && !config.HasExplicitCredential() {
+			if config.ApplicationId == "" {
+				return nil, fmt.Errorf("the client id of the application is required to authenticate with a client assertion")
+			}
+			authenticator = NewWorkloadIdentityAuthenticator(config, auth, api)
 		} else {
 			authenticator = NewGenericAuthenticator(config, auth, api)
 		}
@@ -72,6 +80,8 @@ func NewRestClient(apiUrl string, config config.Config) (RestClient, error) {
 			config.SubscriptionId,
 			config.MgmtGroupId,
 			authenticator,
+			sharedThrottles,
+			NewRetryPolicy(config),
 		}
 		return client, nil
 	}
@@ -85,6 +95,8 @@ type restClient struct {
 	subId         []string
 	mgmtGroupId   []string
 	Authenticator *Authenticator
+	throttles     *hostThrottles
+	retry         RetryPolicy
 }
 
 func (s *restClient) Delete(ctx context.Context, path string, body interface{}, params query.Params, headers map[string]string) (*http.Response, error) {
@@ -178,12 +190,12 @@ func (s *restClient) send(req *http.Request) (*http.Response, error) {
 		return nil, err
 	} else {
 		var (
-			res        *http.Response
-			err        error
-			maxRetries = 3
+			res      *http.Response
+			err      error
+			throttle = s.throttles.Host(req.URL.Host)
 		)
-		// Try the request up to a set number of times
-		for retry := 0; retry < maxRetries; retry++ {
+		// Try the request up to the number of attempts allowed by the retry policy
+		for retry := 0; retry < s.retry.Attempts; retry++ {
 
 			// Reusing http.Request requires rewinding the request body
 			// back to a working state
@@ -191,29 +203,35 @@ func (s *restClient) send(req *http.Request) (*http.Response, error) {
 				req.Body = io.NopCloser(bytes.NewBuffer(body))
 			}
 
+			// Hold the request back while the host is throttling any client
+			if err := throttle.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+
 			// Try the request
 			if res, err = s.http.Do(req); err != nil {
-				if IsClosedConnectionErr(err) {
-					fmt.Printf("remote host force closed connection while requesting %s; attempt %d/%d; trying again\n", req.URL, retry+1, maxRetries)
-					ExponentialBackoff(retry)
+				if s.retry.RetryableError(err) {
+					fmt.Printf("request to %s failed: %v; attempt %d/%d; trying again\n", req.URL, err, retry+1, s.retry.Attempts)
+					if err := s.retry.Wait(req.Context(), retry); err != nil {
+						return nil, err
+					}
 					continue
 				}
 				return nil, err
-			} else if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+			} else if throttled := throttle.Observe(res); res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
 				// Error response code handling
 				// See official Retry guidance (https://learn.microsoft.com/en-us/azure/architecture/best-practices/retry-service-specific#retry-usage-guidance)
-				if res.StatusCode == http.StatusTooManyRequests {
-					retryAfterHeader := res.Header.Get("Retry-After")
-					if retryAfter, err := strconv.ParseInt(retryAfterHeader, 10, 64); err != nil {
-						return nil, fmt.Errorf("attempting to handle 429 but unable to parse retry-after header: %w", err)
-					} else {
-						// Wait the time indicated in the retry-after header
-						time.Sleep(time.Second * time.Duration(retryAfter))
-						continue
+				if throttled {
+					// The host is paused for the time indicated in the retry-after header, which every request waits out
+					// before this one is tried again
+					res.Body.Close()
+					continue
+				} else if s.retry.RetryableStatus(res.StatusCode) {
+					// Wait the backoff of the retry policy
+					res.Body.Close()
+					if err := s.retry.Wait(req.Context(), retry); err != nil {
+						return nil, err
 					}
-				} else if res.StatusCode >= http.StatusInternalServerError {
-					// Wait the time calculated by the 5 second exponential backoff
-					ExponentialBackoff(retry)
 					continue
 				} else {
 					// Not a status code that warrants a retry
@@ -229,7 +247,11 @@ func (s *restClient) send(req *http.Request) (*http.Response, error) {
 				return res, nil
 			}
 		}
-		return nil, fmt.Errorf("unable to complete the request after %d attempts: %w", maxRetries, err)
+		if err != nil {
+			return nil, fmt.Errorf("unable to complete the request after %d attempts: %w", s.retry.Attempts, err)
+		} else {
+			return nil, fmt.Errorf("unable to complete the request after %d attempts, status code: %d", s.retry.Attempts, res.StatusCode)
+		}
 	}
 }
 
@@ -0,0 +1,138 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+package rest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"slices"
+	"syscall"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client/config"
+)
+
+const (
+	DefaultRetryAttempts = 3
+	DefaultRetryDelay    = 5 * time.Second
+	DefaultRetryMaxDelay = time.Minute
+	DefaultRetryJitter   = 0.2
+)
+
+// Transient failures of Azure services, see https://learn.microsoft.com/en-us/azure/architecture/best-practices/retry-service-specific#retry-usage-guidance
+var DefaultRetryStatusCodes = []int{
+	http.StatusRequestTimeout,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy decides which failed requests are tried again and how long to wait in between. Throttled (429) responses
+// are retried regardless of the status codes of the policy, after the pause the host asked for.
+type RetryPolicy struct {
+	Attempts    int           // The number of times a request is tried
+	Delay       time.Duration // The wait before the second attempt, doubled for each one after that
+	MaxDelay    time.Duration // The longest wait between two attempts
+	Jitter      float64       // The fraction by which each wait is randomly shortened or lengthened
+	StatusCodes []int         // The response status codes that are tried again
+}
+
+// NewRetryPolicy creates the retry policy of the given config, using the defaults for any option left unset. A jitter of
+// zero is kept as is and disables the randomization of the waits.
+func NewRetryPolicy(config config.Config) RetryPolicy {
+	policy := RetryPolicy{
+		Attempts:    config.RetryAttempts,
+		Delay:       time.Duration(config.RetryDelay) * time.Second,
+		MaxDelay:    time.Duration(config.RetryMaxDelay) * time.Second,
+		Jitter:      config.RetryJitter,
+		StatusCodes: config.RetryStatusCodes,
+	}
+
+	if policy.Attempts < 1 {
+		policy.Attempts = DefaultRetryAttempts
+	}
+	if policy.Delay <= 0 {
+		policy.Delay = DefaultRetryDelay
+	}
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = DefaultRetryMaxDelay
+	}
+	if policy.Jitter < 0 || policy.Jitter > 1 {
+		policy.Jitter = DefaultRetryJitter
+	}
+	if len(policy.StatusCodes) == 0 {
+		policy.StatusCodes = DefaultRetryStatusCodes
+	}
+	return policy
+}
+
+// RetryableStatus determines if a response with the given status code should be tried again.
+func (s RetryPolicy) RetryableStatus(statusCode int) bool {
+	return slices.Contains(s.StatusCodes, statusCode)
+}
+
+// RetryableError determines if a request that failed with the given error should be tried again. Connections that were
+// closed or reset by the remote host and requests that timed out are retried.
+func (s RetryPolicy) RetryableError(err error) bool {
+	var netErr net.Error
+	if errors.Is(err, context.Canceled) {
+		return false
+	} else if IsClosedConnectionErr(err) || IsGoAwayErr(err) {
+		return true
+	} else if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	} else {
+		return errors.As(err, &netErr) && netErr.Timeout()
+	}
+}
+
+// Backoff returns the wait after the given (zero-based) attempt, before any jitter is applied.
+func (s RetryPolicy) Backoff(retry int) time.Duration {
+	backoff := float64(s.Delay) * math.Pow(2, float64(retry))
+	if backoff > float64(s.MaxDelay) {
+		return s.MaxDelay
+	} else {
+		return time.Duration(backoff)
+	}
+}
+
+// Wait blocks for the jittered backoff of the given (zero-based) attempt or until the context is done. It returns
+// immediately when no attempts remain.
+func (s RetryPolicy) Wait(ctx context.Context, retry int) error {
+	if retry+1 >= s.Attempts {
+		return ctx.Err()
+	}
+
+	backoff := float64(s.Backoff(retry))
+	backoff += backoff * s.Jitter * (2*rand.Float64() - 1)
+
+	timer := time.NewTimer(time.Duration(backoff))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
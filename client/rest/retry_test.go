@@ -0,0 +1,143 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client/config"
+)
+
+func TestNewRetryPolicy(t *testing.T) {
+	policy := NewRetryPolicy(config.Config{})
+	if policy.Attempts != DefaultRetryAttempts || policy.Delay != DefaultRetryDelay || policy.MaxDelay != DefaultRetryMaxDelay {
+		t.Errorf("expected the defaults for an empty config, got %+v", policy)
+	}
+
+	policy = NewRetryPolicy(config.Config{RetryAttempts: 5, RetryDelay: 1, RetryMaxDelay: 10, RetryJitter: 0.5, RetryStatusCodes: []int{http.StatusConflict}})
+	if policy.Attempts != 5 || policy.Delay != time.Second || policy.MaxDelay != 10*time.Second || policy.Jitter != 0.5 {
+		t.Errorf("expected the config to be used, got %+v", policy)
+	} else if !policy.RetryableStatus(http.StatusConflict) || policy.RetryableStatus(http.StatusInternalServerError) {
+		t.Errorf("expected only the configured status codes to be retried")
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{Delay: time.Second, MaxDelay: 5 * time.Second}
+	for retry, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		if backoff := policy.Backoff(retry); backoff != expected {
+			t.Errorf("got %v for retry %d; want %v", backoff, retry, expected)
+		}
+	}
+}
+
+type timeoutErr struct{}
+
+func (timeoutErr) Error() string   { return "i/o timeout" }
+func (timeoutErr) Timeout() bool   { return true }
+func (timeoutErr) Temporary() bool { return true }
+
+func TestRetryPolicyRetryableError(t *testing.T) {
+	policy := NewRetryPolicy(config.Config{})
+
+	retryable := []error{
+		fmt.Errorf("read: %w", syscall.ECONNRESET),
+		io.ErrUnexpectedEOF,
+		&net.OpError{Op: "read", Err: timeoutErr{}},
+	}
+	for _, err := range retryable {
+		if !policy.RetryableError(err) {
+			t.Errorf("expected %v to be retried", err)
+		}
+	}
+
+	for _, err := range []error{context.Canceled, fmt.Errorf("unsupported protocol scheme")} {
+		if policy.RetryableError(err) {
+			t.Errorf("expected %v not to be retried", err)
+		}
+	}
+}
+
+func TestRetryPolicyWait(t *testing.T) {
+	policy := RetryPolicy{Attempts: 3, Delay: time.Hour, MaxDelay: time.Hour}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := policy.Wait(ctx, 0); err != context.DeadlineExceeded {
+		t.Errorf("got %v; want %v", err, context.DeadlineExceeded)
+	} else if err := policy.Wait(context.Background(), 2); err != nil {
+		t.Errorf("expected no wait once no attempts remain, got %v", err)
+	}
+}
+
+func TestRetriedStatusCode(t *testing.T) {
+	attempt := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt++
+		if attempt < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer testServer.Close()
+
+	client := &restClient{
+		http:      testServer.Client(),
+		throttles: newHostThrottles(),
+		retry:     RetryPolicy{Attempts: 3, Delay: time.Millisecond, MaxDelay: time.Millisecond, StatusCodes: DefaultRetryStatusCodes},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	} else if res, err := client.send(req); err != nil {
+		t.Fatalf("expected the request to be retried: %v", err)
+	} else {
+		res.Body.Close()
+		if attempt != 3 {
+			t.Errorf("got %d attempts; want 3", attempt)
+		}
+	}
+}
+
+func TestRetriedStatusCodeExhausted(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer testServer.Close()
+
+	client := &restClient{
+		http:      testServer.Client(),
+		throttles: newHostThrottles(),
+		retry:     RetryPolicy{Attempts: 2, Delay: time.Millisecond, MaxDelay: time.Millisecond, StatusCodes: DefaultRetryStatusCodes},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	} else if _, err := client.send(req); err == nil || err.Error() != "unable to complete the request after 2 attempts, status code: 502" {
+		t.Errorf("got error %v; want the status code of the last attempt", err)
+	}
+}
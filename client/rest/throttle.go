@@ -0,0 +1,174 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Resource Manager reports how many requests remain in its token buckets through headers such as
+	// x-ms-ratelimit-remaining-subscription-reads and refills them every second. A host is paused for
+	// lowRemainingPause once fewer than lowRemainingThreshold requests remain.
+	// See https://learn.microsoft.com/en-us/azure/azure-resource-manager/management/request-limits-and-throttling
+	rateLimitRemainingPrefix = "X-Ms-Ratelimit-Remaining-"
+	lowRemainingThreshold    = 10
+	lowRemainingPause        = time.Second
+
+	// Microsoft Graph reports the share of its limit an application has used once it passes 80%.
+	// See https://learn.microsoft.com/en-us/graph/throttling#regular-responses-requests
+	throttleLimitPercentageHeader = "X-Ms-Throttle-Limit-Percentage"
+	highLimitPercentage           = 1.0
+
+	// Used when a throttled response does not say how long to wait
+	defaultRetryAfter = 5 * time.Second
+)
+
+// hostThrottles holds the throttle of each host, shared by every RestClient so that a host throttling one client pauses
+// the requests of all of them.
+type hostThrottles struct {
+	mutex sync.Mutex
+	hosts map[string]*hostThrottle
+}
+
+var sharedThrottles = newHostThrottles()
+
+func newHostThrottles() *hostThrottles {
+	return &hostThrottles{hosts: make(map[string]*hostThrottle)}
+}
+
+func (s *hostThrottles) Host(host string) *hostThrottle {
+	host = strings.ToLower(host)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if throttle, ok := s.hosts[host]; ok {
+		return throttle
+	} else {
+		throttle := &hostThrottle{}
+		s.hosts[host] = throttle
+		return throttle
+	}
+}
+
+// hostThrottle pauses the requests to a single host while it is throttling them.
+type hostThrottle struct {
+	mutex sync.Mutex
+	until time.Time
+}
+
+// Wait blocks until the host accepts requests again or the context is done.
+func (s *hostThrottle) Wait(ctx context.Context) error {
+	for {
+		s.mutex.Lock()
+		delay := time.Until(s.until)
+		s.mutex.Unlock()
+
+		if delay <= 0 {
+			return nil
+		}
+
+		// The pause may be extended while waiting, so check again once this one is over
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Pause holds back every request to the host for at least the given duration.
+func (s *hostThrottle) Pause(delay time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if until := time.Now().Add(delay); until.After(s.until) {
+		s.until = until
+	}
+}
+
+// Observe learns from the throttling headers of a response. It pauses the host for as long as a throttled (429) or
+// unavailable (503) response asks, or briefly when the host reports that its limits are close to being reached, and
+// returns whether the request should be retried once the pause is over.
+func (s *hostThrottle) Observe(res *http.Response) bool {
+	if res.StatusCode == http.StatusTooManyRequests {
+		if delay, ok := retryAfter(res.Header); ok {
+			s.Pause(delay)
+		} else {
+			s.Pause(defaultRetryAfter)
+		}
+		return true
+	} else if res.StatusCode == http.StatusServiceUnavailable {
+		if delay, ok := retryAfter(res.Header); ok {
+			s.Pause(delay)
+			return true
+		}
+	} else if remaining, ok := rateLimitRemaining(res.Header); ok && remaining < lowRemainingThreshold {
+		s.Pause(lowRemainingPause)
+	} else if percentage, err := strconv.ParseFloat(res.Header.Get(throttleLimitPercentageHeader), 64); err == nil && percentage >= highLimitPercentage {
+		s.Pause(lowRemainingPause)
+	}
+	return false
+}
+
+// ObserveThrottling feeds the status and headers of a response that was not received by a RestClient on its own, such
+// as one inside a Microsoft Graph $batch call, into the throttle shared by every request to its host. It returns
+// whether the request should be retried once the host accepts requests again.
+func ObserveThrottling(host string, statusCode int, header http.Header) bool {
+	return sharedThrottles.Host(host).Observe(&http.Response{StatusCode: statusCode, Header: header})
+}
+
+// retryAfter reads a Retry-After header given in either seconds or as an HTTP date.
+func retryAfter(header http.Header) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	} else if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds * float64(time.Second)), true
+	} else if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	} else {
+		return 0, false
+	}
+}
+
+// rateLimitRemaining returns the lowest of the x-ms-ratelimit-remaining-* headers of a response.
+func rateLimitRemaining(header http.Header) (int64, bool) {
+	var (
+		lowest int64 = math.MaxInt64
+		found  bool
+	)
+	for key, values := range header {
+		if !strings.HasPrefix(http.CanonicalHeaderKey(key), rateLimitRemainingPrefix) || len(values) == 0 {
+			continue
+		} else if remaining, err := strconv.ParseInt(values[0], 10, 64); err == nil && remaining < lowest {
+			lowest = remaining
+			found = true
+		}
+	}
+	return lowest, found
+}
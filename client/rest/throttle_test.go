@@ -0,0 +1,124 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client/config"
+)
+
+func TestRetryAfter(t *testing.T) {
+	header := http.Header{}
+	if _, ok := retryAfter(header); ok {
+		t.Errorf("expected a missing retry-after header to be ignored")
+	}
+
+	header.Set("Retry-After", "2")
+	if delay, ok := retryAfter(header); !ok || delay != 2*time.Second {
+		t.Errorf("got %v, %v; want 2s, true", delay, ok)
+	}
+
+	header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	if delay, ok := retryAfter(header); !ok || delay <= 0 || delay > time.Minute {
+		t.Errorf("got %v, %v; want a delay of up to a minute", delay, ok)
+	}
+
+	header.Set("Retry-After", "soon")
+	if _, ok := retryAfter(header); ok {
+		t.Errorf("expected a malformed retry-after header to be ignored")
+	}
+}
+
+func TestRateLimitRemaining(t *testing.T) {
+	header := http.Header{}
+	if _, ok := rateLimitRemaining(header); ok {
+		t.Errorf("expected no remaining requests to be reported")
+	}
+
+	header.Set("x-ms-ratelimit-remaining-subscription-reads", "11999")
+	header.Set("x-ms-ratelimit-remaining-tenant-reads", "42")
+	if remaining, ok := rateLimitRemaining(header); !ok || remaining != 42 {
+		t.Errorf("got %d, %v; want 42, true", remaining, ok)
+	}
+}
+
+func TestHostThrottleObserve(t *testing.T) {
+	throttle := &hostThrottle{}
+	res := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	if !throttle.Observe(res) {
+		t.Fatalf("expected a 429 without a retry-after header to be retried")
+	} else if delay := time.Until(throttle.until); delay <= 0 || delay > defaultRetryAfter {
+		t.Errorf("got a pause of %v; want up to %v", delay, defaultRetryAfter)
+	}
+
+	throttle = &hostThrottle{}
+	res = &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	res.Header.Set("x-ms-ratelimit-remaining-subscription-reads", "3")
+	if throttle.Observe(res) {
+		t.Errorf("expected a successful response not to be retried")
+	} else if !throttle.until.After(time.Now()) {
+		t.Errorf("expected a host running out of requests to be paused")
+	}
+
+	throttle = &hostThrottle{}
+	res = &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+	if throttle.Observe(res) || !throttle.until.IsZero() {
+		t.Errorf("expected a 503 without a retry-after header to be left to the retry policy")
+	}
+}
+
+func TestHostThrottleWait(t *testing.T) {
+	throttle := &hostThrottle{}
+	throttle.Pause(time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := throttle.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("got %v; want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestThrottledRequest(t *testing.T) {
+	attempt := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt++
+		if attempt == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer testServer.Close()
+
+	client := &restClient{http: testServer.Client(), throttles: newHostThrottles(), retry: NewRetryPolicy(config.Config{})}
+	req, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	} else if res, err := client.send(req); err != nil {
+		t.Fatalf("expected the throttled request to be retried: %v", err)
+	} else {
+		res.Body.Close()
+		if attempt != 2 {
+			t.Errorf("got %d attempts; want 2", attempt)
+		}
+	}
+}
@@ -141,11 +141,6 @@ func IsGoAwayErr(err error) bool {
 	return errors.As(err, &goAwayErr)
 }
 
-func ExponentialBackoff(retry int) {
-	backoff := math.Pow(5, float64(retry+1))
-	time.Sleep(time.Second * time.Duration(backoff))
-}
-
 func VariableExponentialBackoff(base, retry int) {
 	backoff := math.Pow(float64(base), float64(retry+1))
 	time.Sleep(time.Second * time.Duration(backoff))
@@ -0,0 +1,162 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/client/rest"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+const atScopeFilter = "atScope()"
+
+var errNotIndexed = errors.New("role assignments of the scope are not indexed")
+
+// roleAssignmentIndex answers per-resource role assignment requests from a single listing of each subscription's role
+// assignments, made the first time a scope within the subscription is requested.
+//
+// Without a filter the role assignments API returns the assignments at, above and below the requested scope, and with
+// atScope() those at and above it. Listing a subscription therefore returns every assignment that applies to any of its
+// resources: the ones above the subscription (the root scope and its management groups) apply to all of them, and the
+// ones within it apply to the scopes they are made at and everything below.
+type roleAssignmentIndex struct {
+	client        rest.RestClient
+	mutex         sync.Mutex
+	subscriptions map[string]*subscriptionRoleAssignments
+}
+
+type subscriptionRoleAssignments struct {
+	ready chan struct{}
+	err   error
+
+	// Assignments made above the subscription
+	inherited []azure.RoleAssignment
+
+	// Assignments made at or below the subscription, keyed by lower case scope
+	byScope map[string][]azure.RoleAssignment
+
+	// The keys of byScope in sorted order, so the scopes below a given scope are found with a binary search
+	scopes []string
+}
+
+func newRoleAssignmentIndex(client rest.RestClient) *roleAssignmentIndex {
+	return &roleAssignmentIndex{
+		client:        client,
+		subscriptions: make(map[string]*subscriptionRoleAssignments),
+	}
+}
+
+// Lookup returns the role assignments that ListRoleAssignmentsForResource would for a scope within a subscription. It
+// returns an error if the scope or filter cannot be answered from the index or the subscription could not be listed.
+func (s *roleAssignmentIndex) Lookup(ctx context.Context, resourceId string, filter string) ([]azure.RoleAssignment, error) {
+	if filter != "" && filter != atScopeFilter {
+		return nil, errNotIndexed
+	}
+
+	scope := strings.TrimSuffix(strings.ToLower(resourceId), "/")
+	segments := strings.Split(scope, "/")
+	if len(segments) < 3 || segments[0] != "" || segments[1] != "subscriptions" || segments[2] == "" {
+		return nil, errNotIndexed
+	}
+
+	subscription := s.subscription(ctx, strings.Split(resourceId, "/")[2])
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-subscription.ready:
+	}
+
+	if subscription.err != nil {
+		return nil, subscription.err
+	}
+
+	assignments := append([]azure.RoleAssignment{}, subscription.inherited...)
+
+	// Assignments at the scope and its ancestors within the subscription
+	for i := 3; i <= len(segments); i++ {
+		assignments = append(assignments, subscription.byScope[strings.Join(segments[:i], "/")]...)
+	}
+
+	// Assignments below the scope
+	if filter != atScopeFilter {
+		prefix := scope + "/"
+		for i := sort.SearchStrings(subscription.scopes, prefix); i < len(subscription.scopes) && strings.HasPrefix(subscription.scopes[i], prefix); i++ {
+			assignments = append(assignments, subscription.byScope[subscription.scopes[i]]...)
+		}
+	}
+
+	return assignments, nil
+}
+
+// subscription returns the index entry of a subscription, listing its role assignments if this is the first request.
+func (s *roleAssignmentIndex) subscription(ctx context.Context, subscriptionId string) *subscriptionRoleAssignments {
+	key := strings.ToLower(subscriptionId)
+
+	s.mutex.Lock()
+	if subscription, ok := s.subscriptions[key]; ok {
+		s.mutex.Unlock()
+		return subscription
+	}
+
+	subscription := &subscriptionRoleAssignments{ready: make(chan struct{})}
+	s.subscriptions[key] = subscription
+	s.mutex.Unlock()
+
+	defer close(subscription.ready)
+	subscription.load(ctx, s.client, subscriptionId)
+	return subscription
+}
+
+func (s *subscriptionRoleAssignments) load(ctx context.Context, client rest.RestClient, subscriptionId string) {
+	var (
+		out          = make(chan AzureResult[azure.RoleAssignment])
+		subscription = strings.ToLower(fmt.Sprintf("/subscriptions/%s", subscriptionId))
+		path         = fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Authorization/roleAssignments", subscriptionId)
+		params       = query.RMParams{ApiVersion: "2015-07-01"}
+	)
+
+	s.byScope = make(map[string][]azure.RoleAssignment)
+
+	go getAzureObjectList[azure.RoleAssignment](client, ctx, path, params, out)
+
+	for item := range out {
+		if item.Error != nil {
+			s.err = fmt.Errorf("unable to list role assignments of subscription %s: %w", subscriptionId, item.Error)
+		} else if scope := strings.TrimSuffix(strings.ToLower(item.Ok.Properties.Scope), "/"); scope == subscription || strings.HasPrefix(scope, subscription+"/") {
+			s.byScope[scope] = append(s.byScope[scope], item.Ok)
+		} else {
+			s.inherited = append(s.inherited, item.Ok)
+		}
+	}
+
+	if s.err == nil && ctx.Err() != nil {
+		s.err = ctx.Err()
+	}
+
+	for scope := range s.byScope {
+		s.scopes = append(s.scopes, scope)
+	}
+	sort.Strings(s.scopes)
+}
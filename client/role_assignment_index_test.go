@@ -0,0 +1,134 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/stretchr/testify/require"
+)
+
+func roleAssignmentsResponse(t *testing.T, scopes ...string) *http.Response {
+	var list struct {
+		Value []azure.RoleAssignment `json:"value"`
+	}
+	for i, scope := range scopes {
+		list.Value = append(list.Value, azure.RoleAssignment{
+			Id:         fmt.Sprintf("%s/providers/Microsoft.Authorization/roleAssignments/%d", strings.TrimSuffix(scope, "/"), i),
+			Properties: azure.RoleAssignmentPropertiesWithScope{Scope: scope},
+		})
+	}
+	body, err := json.Marshal(list)
+	require.NoError(t, err)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(string(body))),
+	}
+}
+
+func assignmentScopes(assignments []azure.RoleAssignment) []string {
+	var scopes []string
+	for _, assignment := range assignments {
+		scopes = append(scopes, assignment.Properties.Scope)
+	}
+	return scopes
+}
+
+func TestRoleAssignmentIndex_Lookup(t *testing.T) {
+	var requests atomic.Int32
+	client := &fakeRestClient{
+		getFunc: func(ctx context.Context, path string, params query.Params, headers map[string]string) (*http.Response, error) {
+			requests.Add(1)
+			require.Equal(t, "/subscriptions/SUB/providers/Microsoft.Authorization/roleAssignments", path)
+			return roleAssignmentsResponse(t,
+				"/",
+				"/providers/Microsoft.Management/managementGroups/mg",
+				"/subscriptions/sub",
+				"/subscriptions/sub/resourceGroups/rg",
+				"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm",
+				"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm/extensions/ext",
+				"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm2",
+				"/subscriptions/sub/resourceGroups/rg2",
+			), nil
+		},
+	}
+	index := newRoleAssignmentIndex(client)
+	ctx := context.Background()
+
+	assignments, err := index.Lookup(ctx, "/subscriptions/SUB/resourceGroups/RG/providers/Microsoft.Compute/virtualMachines/VM", "")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		"/",
+		"/providers/Microsoft.Management/managementGroups/mg",
+		"/subscriptions/sub",
+		"/subscriptions/sub/resourceGroups/rg",
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm",
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm/extensions/ext",
+	}, assignmentScopes(assignments))
+
+	assignments, err = index.Lookup(ctx, "/subscriptions/sub", atScopeFilter)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		"/",
+		"/providers/Microsoft.Management/managementGroups/mg",
+		"/subscriptions/sub",
+	}, assignmentScopes(assignments))
+
+	_, err = index.Lookup(ctx, "/providers/Microsoft.Management/managementGroups/mg", atScopeFilter)
+	require.ErrorIs(t, err, errNotIndexed)
+
+	_, err = index.Lookup(ctx, "/subscriptions/sub", "principalId eq 'id'")
+	require.ErrorIs(t, err, errNotIndexed)
+
+	require.Equal(t, int32(1), requests.Load())
+}
+
+func TestListRoleAssignmentsForResource_FallsBackWhenSubscriptionFails(t *testing.T) {
+	var paths []string
+	rm := &fakeRestClient{
+		getFunc: func(ctx context.Context, path string, params query.Params, headers map[string]string) (*http.Response, error) {
+			paths = append(paths, path)
+			if path == "/subscriptions/sub/providers/Microsoft.Authorization/roleAssignments" {
+				return nil, fmt.Errorf("forbidden")
+			}
+			return roleAssignmentsResponse(t, "/subscriptions/sub/resourceGroups/rg"), nil
+		},
+	}
+	client := &azureClient{resourceManager: rm, roleAssignments: newRoleAssignmentIndex(rm)}
+
+	var scopes []string
+	for item := range client.ListRoleAssignmentsForResource(context.Background(), "/subscriptions/sub/resourceGroups/rg", "", "") {
+		require.NoError(t, item.Error)
+		scopes = append(scopes, item.Ok.Properties.Scope)
+	}
+
+	require.Equal(t, []string{"/subscriptions/sub/resourceGroups/rg"}, scopes)
+	require.Equal(t, []string{
+		"/subscriptions/sub/providers/Microsoft.Authorization/roleAssignments",
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Authorization/roleAssignments",
+	}, paths)
+}
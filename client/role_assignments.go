@@ -24,6 +24,8 @@ import (
 	"github.com/bloodhoundad/azurehound/v2/client/query"
 	"github.com/bloodhoundad/azurehound/v2/constants"
 	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
 )
 
 // ListAzureADRoleAssignments https://learn.microsoft.com/en-us/graph/api/rbacapplication-list-roleassignments?view=graph-rest-beta
@@ -49,7 +51,26 @@ func (s *azureClient) ListRoleAssignmentsForResource(ctx context.Context, resour
 		params = query.RMParams{ApiVersion: "2015-07-01", Filter: filter, TenantId: tenantId}
 	)
 
-	go getAzureObjectList[azure.RoleAssignment](s.resourceManager, ctx, path, params, out)
+	if s.roleAssignments == nil || tenantId != "" {
+		go getAzureObjectList[azure.RoleAssignment](s.resourceManager, ctx, path, params, out)
+	} else {
+		go func() {
+			defer panicrecovery.PanicRecovery()
+
+			// Scopes outside of a subscription, unsupported filters and subscriptions that could not be listed fall
+			// back to a request of their own
+			if assignments, err := s.roleAssignments.Lookup(ctx, resourceId, filter); err != nil {
+				getAzureObjectList[azure.RoleAssignment](s.resourceManager, ctx, path, params, out)
+			} else {
+				defer close(out)
+				for _, assignment := range assignments {
+					if ok := pipeline.Send(ctx.Done(), out, AzureResult[azure.RoleAssignment]{Ok: assignment}); !ok {
+						return
+					}
+				}
+			}
+		}()
+	}
 
 	return out
 }
@@ -47,14 +47,26 @@ func (s *azureClient) ListAzureADServicePrincipals(ctx context.Context, params q
 func (s *azureClient) ListAzureADServicePrincipalOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage] {
 	var (
 		out  = make(chan AzureResult[json.RawMessage])
-		path = fmt.Sprintf("/%s/servicePrincipals/%s/owners", constants.GraphApiBetaVersion, objectId)
+		path = fmt.Sprintf("/servicePrincipals/%s/owners", objectId)
 	)
 
 	if params.Top == 0 {
 		params.Top = 999
 	}
 
-	go getAzureObjectList[json.RawMessage](s.msgraph, ctx, path, params, out)
+	go getGraphObjectList[json.RawMessage](s, ctx, constants.GraphApiBetaVersion, path, params, out)
+
+	return out
+}
+
+// ListAzureADServicePrincipalsDelta https://learn.microsoft.com/en-us/graph/api/serviceprincipal-delta?view=graph-rest-1.0
+func (s *azureClient) ListAzureADServicePrincipalsDelta(ctx context.Context, deltaLink string, params query.GraphParams) <-chan AzureResult[azure.DeltaItem[azure.ServicePrincipal]] {
+	var (
+		out  = make(chan AzureResult[azure.DeltaItem[azure.ServicePrincipal]])
+		path = fmt.Sprintf("/%s/servicePrincipals/delta", constants.GraphApiVersion)
+	)
+
+	go getAzureDeltaList[azure.ServicePrincipal](s.msgraph, ctx, path, deltaLink, params, out)
 
 	return out
 }
@@ -0,0 +1,93 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/client/rest"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// Operations shared by every App Service site, i.e. both web apps and function apps
+
+// ListAzureSitePublishingCredentialsPolicies https://learn.microsoft.com/en-us/rest/api/appservice/web-apps/list-basic-publishing-credentials-policies?view=rest-appservice-2022-03-01
+func (s *azureClient) ListAzureSitePublishingCredentialsPolicies(ctx context.Context, siteId string) <-chan AzureResult[azure.SitePublishingCredentialsPolicy] {
+	var (
+		out    = make(chan AzureResult[azure.SitePublishingCredentialsPolicy])
+		path   = fmt.Sprintf("%s/basicPublishingCredentialsPolicies", siteId)
+		params = query.RMParams{ApiVersion: "2022-03-01"}
+	)
+
+	go getAzureObjectList[azure.SitePublishingCredentialsPolicy](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// GetAzureSiteAuthSettingsV2 https://learn.microsoft.com/en-us/rest/api/appservice/web-apps/get-auth-settings-v2?view=rest-appservice-2022-03-01
+func (s *azureClient) GetAzureSiteAuthSettingsV2(ctx context.Context, siteId string) (azure.SiteAuthSettingsV2, error) {
+	var (
+		path     = fmt.Sprintf("%s/config/authsettingsV2", siteId)
+		params   = query.RMParams{ApiVersion: "2022-03-01"}
+		response azure.SiteAuthSettingsV2
+	)
+
+	if res, err := s.resourceManager.Get(ctx, path, params, nil); err != nil {
+		return response, err
+	} else if err := rest.Decode(res.Body, &response); err != nil {
+		return response, err
+	} else {
+		return response, nil
+	}
+}
+
+// GetAzureSiteConfig https://learn.microsoft.com/en-us/rest/api/appservice/web-apps/get-configuration?view=rest-appservice-2022-03-01
+func (s *azureClient) GetAzureSiteConfig(ctx context.Context, siteId string) (azure.SiteConfigResource, error) {
+	var (
+		path     = fmt.Sprintf("%s/config/web", siteId)
+		params   = query.RMParams{ApiVersion: "2022-03-01"}
+		response azure.SiteConfigResource
+	)
+
+	if res, err := s.resourceManager.Get(ctx, path, params, nil); err != nil {
+		return response, err
+	} else if err := rest.Decode(res.Body, &response); err != nil {
+		return response, err
+	} else {
+		return response, nil
+	}
+}
+
+// GetAzureSiteSourceControl https://learn.microsoft.com/en-us/rest/api/appservice/web-apps/get-source-control?view=rest-appservice-2022-03-01
+func (s *azureClient) GetAzureSiteSourceControl(ctx context.Context, siteId string) (azure.SiteSourceControl, error) {
+	var (
+		path     = fmt.Sprintf("%s/sourcecontrols/web", siteId)
+		params   = query.RMParams{ApiVersion: "2022-03-01"}
+		response azure.SiteSourceControl
+	)
+
+	if res, err := s.resourceManager.Get(ctx, path, params, nil); err != nil {
+		return response, err
+	} else if err := rest.Decode(res.Body, &response); err != nil {
+		return response, err
+	} else {
+		return response, nil
+	}
+}
@@ -54,3 +54,46 @@ func (s *azureClient) ListAzureStorageContainers(ctx context.Context, subscripti
 
 	return out
 }
+
+// ==
+// File shares, queues and tables
+// ==
+
+// ListAzureStorageFileShares https://learn.microsoft.com/en-us/rest/api/storagerp/file-shares/list?view=rest-storagerp-2023-01-01
+func (s *azureClient) ListAzureStorageFileShares(ctx context.Context, storageAccountId string) <-chan AzureResult[azure.StorageFileShare] {
+	var (
+		out    = make(chan AzureResult[azure.StorageFileShare])
+		path   = fmt.Sprintf("%s/fileServices/default/shares", storageAccountId)
+		params = query.RMParams{ApiVersion: "2023-01-01"}
+	)
+
+	go getAzureObjectList[azure.StorageFileShare](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureStorageQueues https://learn.microsoft.com/en-us/rest/api/storagerp/queue/list?view=rest-storagerp-2023-01-01
+func (s *azureClient) ListAzureStorageQueues(ctx context.Context, storageAccountId string) <-chan AzureResult[azure.StorageQueue] {
+	var (
+		out    = make(chan AzureResult[azure.StorageQueue])
+		path   = fmt.Sprintf("%s/queueServices/default/queues", storageAccountId)
+		params = query.RMParams{ApiVersion: "2023-01-01"}
+	)
+
+	go getAzureObjectList[azure.StorageQueue](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureStorageTables https://learn.microsoft.com/en-us/rest/api/storagerp/table/list?view=rest-storagerp-2023-01-01
+func (s *azureClient) ListAzureStorageTables(ctx context.Context, storageAccountId string) <-chan AzureResult[azure.StorageTable] {
+	var (
+		out    = make(chan AzureResult[azure.StorageTable])
+		path   = fmt.Sprintf("%s/tableServices/default/tables", storageAccountId)
+		params = query.RMParams{ApiVersion: "2023-01-01"}
+	)
+
+	go getAzureObjectList[azure.StorageTable](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
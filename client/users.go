@@ -41,3 +41,15 @@ func (s *azureClient) ListAzureADUsers(ctx context.Context, params query.GraphPa
 
 	return out
 }
+
+// ListAzureADUsersDelta https://learn.microsoft.com/en-us/graph/api/user-delta?view=graph-rest-1.0
+func (s *azureClient) ListAzureADUsersDelta(ctx context.Context, deltaLink string, params query.GraphParams) <-chan AzureResult[azure.DeltaItem[azure.User]] {
+	var (
+		out  = make(chan AzureResult[azure.DeltaItem[azure.User]])
+		path = fmt.Sprintf("/%s/users/delta", constants.GraphApiVersion)
+	)
+
+	go getAzureDeltaList[azure.User](s.msgraph, ctx, path, deltaLink, params, out)
+
+	return out
+}
@@ -134,6 +134,14 @@ func configure() error {
 				// System-Assigned: Set client ID to empty string
 				config.AzManagedIdentityClientId.Set("")
 			}
+		} else if authMethod == enums.DeviceCode || authMethod == enums.BrowserLogin {
+			if clientId, err := prompt("Public Client ID (optional)", validateOptionalGuid, false); err != nil {
+				return err
+			} else {
+				config.AzUseDeviceCode.Set(authMethod == enums.DeviceCode)
+				config.AzUseBrowserLogin.Set(authMethod == enums.BrowserLogin)
+				config.AzPublicClientId.Set(clientId)
+			}
 		} else if secret, err := prompt("Client Secret", nil, true); err != nil {
 			return err
 		} else {
@@ -255,6 +263,14 @@ func validateGuid(input string) error {
 	return err
 }
 
+func validateOptionalGuid(input string) error {
+	if input == "" {
+		return nil
+	} else {
+		return validateGuid(input)
+	}
+}
+
 func validatePem(input string) error {
 	if content, err := ioutil.ReadFile(input); err != nil {
 		return err
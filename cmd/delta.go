@@ -0,0 +1,271 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+)
+
+// deltaState holds the Microsoft Graph delta links of an incremental collection, persisted between runs in a local
+// state file keyed by tenant.
+//
+// Links from the current run only replace the stored ones once Save is called, so a collection that fails or is
+// interrupted is repeated from the same point next time.
+type deltaState struct {
+	path     string
+	tenantId string
+	mutex    sync.Mutex
+	tenants  map[string]map[enums.Kind]string
+	pending  map[enums.Kind]string
+}
+
+type deltaStateFile struct {
+	Tenants map[string]map[enums.Kind]string `json:"tenants"`
+}
+
+func loadDeltaState(path string, tenantId string) (*deltaState, error) {
+	var file deltaStateFile
+
+	if data, err := os.ReadFile(path); errors.Is(err, fs.ErrNotExist) {
+		file.Tenants = make(map[string]map[enums.Kind]string)
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to read delta state file: %w", err)
+	} else if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("unable to parse delta state file %s: %w", path, err)
+	} else if file.Tenants == nil {
+		file.Tenants = make(map[string]map[enums.Kind]string)
+	}
+
+	return &deltaState{
+		path:     path,
+		tenantId: strings.ToLower(tenantId),
+		tenants:  file.Tenants,
+		pending:  make(map[enums.Kind]string),
+	}, nil
+}
+
+// openDeltaState loads the delta state of the client's tenant when an incremental collection is requested and returns
+// nil for a full collection.
+func openDeltaState(client client.AzureClient, incremental bool) (*deltaState, error) {
+	if !incremental {
+		return nil, nil
+	}
+	return loadDeltaState(config.DeltaStateFile.Value().(string), client.TenantInfo().TenantId)
+}
+
+// saveDeltaState keeps the delta links of a collection whose output was fully written, so the next incremental
+// collection resumes after it.
+func saveDeltaState(ctx context.Context, delta *deltaState) {
+	if delta == nil || ctx.Err() != nil {
+		return
+	} else if err := delta.Save(); err != nil {
+		log.Error(err, "unable to save delta state; the next incremental collection will repeat this one")
+	}
+}
+
+// Link returns the stored delta link of a kind, or an empty string if the kind was never collected for the tenant.
+func (s *deltaState) Link(kind enums.Kind) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.tenants[s.tenantId][kind]
+}
+
+func (s *deltaState) Update(kind enums.Kind, link string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.pending[kind] = link
+}
+
+// Save writes the delta links of the current run to the state file.
+func (s *deltaState) Save() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.pending) == 0 {
+		return nil
+	}
+
+	if s.tenants[s.tenantId] == nil {
+		s.tenants[s.tenantId] = make(map[enums.Kind]string)
+	}
+	for kind, link := range s.pending {
+		s.tenants[s.tenantId][kind] = link
+	}
+	s.pending = make(map[enums.Kind]string)
+
+	// Write to a temporary file first so a crash never leaves a truncated state file behind
+	tmp := s.path + ".tmp"
+	if data, err := json.MarshalIndent(deltaStateFile{Tenants: s.tenants}, "", "  "); err != nil {
+		return err
+	} else if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return fmt.Errorf("unable to create delta state directory: %w", err)
+	} else if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("unable to write delta state file: %w", err)
+	} else if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("unable to write delta state file: %w", err)
+	} else {
+		return nil
+	}
+}
+
+// directoryObjects are the apps, groups, service principals and users that listAllAD builds on.
+//
+// In incremental mode they only hold the objects changed since the previous collection, while removed objects and
+// delta markers are sent on removed. Owners, members and app role assignments are then only listed for changed
+// objects.
+type directoryObjects struct {
+	apps              <-chan azureWrapper[models.App]
+	groups            <-chan interface{}
+	servicePrincipals <-chan interface{}
+	users             <-chan interface{}
+	removed           <-chan interface{}
+}
+
+func listDirectoryObjects(ctx context.Context, client client.AzureClient, delta *deltaState) directoryObjects {
+	if delta == nil {
+		return directoryObjects{
+			apps:              listApps(ctx, client),
+			groups:            listGroups(ctx, client),
+			servicePrincipals: listServicePrincipals(ctx, client),
+			users:             listUsers(ctx, client),
+		}
+	}
+
+	var (
+		objects directoryObjects
+		removed = make([]<-chan interface{}, 4)
+	)
+	objects.apps, removed[0] = listAppsDelta(ctx, client, delta)
+	objects.groups, removed[1] = listGroupsDelta(ctx, client, delta)
+	objects.servicePrincipals, removed[2] = listServicePrincipalsDelta(ctx, client, delta)
+	objects.users, removed[3] = listUsersDelta(ctx, client, delta)
+	objects.removed = pipeline.Mux(ctx.Done(), removed...)
+	return objects
+}
+
+// listDelta runs a round of a Microsoft Graph delta query for a kind, resuming from the stored delta link when there
+// is one. The params only apply to the first request of a round without a stored link, as the links carry them.
+// Changed objects are converted by wrap, which may skip them, and sent on the first channel; removed objects and, once
+// the round completes, a delta marker are sent on the second.
+func listDelta[T, W any](
+	ctx context.Context,
+	client client.AzureClient,
+	delta *deltaState,
+	kind enums.Kind,
+	list func(ctx context.Context, deltaLink string, params query.GraphParams) <-chan client.AzureResult[azure.DeltaItem[T]],
+	params query.GraphParams,
+	wrap func(item azure.DeltaItem[T]) (W, bool),
+) (<-chan W, <-chan interface{}) {
+	var (
+		changed = make(chan W)
+		removed = make(chan interface{})
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(changed)
+		defer close(removed)
+
+		var (
+			tenantId     = client.TenantInfo().TenantId
+			changedCount = 0
+			removedCount = 0
+		)
+
+		round := func(link string) (string, error) {
+			for item := range list(ctx, link, params) {
+				if item.Error != nil {
+					return "", item.Error
+				} else if item.Ok.DeltaLink != "" {
+					return item.Ok.DeltaLink, nil
+				} else if item.Ok.Removed != nil {
+					log.V(2).Info("found removed object", "kind", kind, "id", item.Ok.Id, "reason", item.Ok.Removed.Reason)
+					removedCount++
+					if ok := pipeline.SendAny(ctx.Done(), removed, AzureWrapper{
+						Kind: enums.KindAZDeletedObject,
+						Data: models.DeletedObject{
+							Id:       item.Ok.Id,
+							Kind:     kind,
+							Reason:   item.Ok.Removed.Reason,
+							TenantId: tenantId,
+						},
+					}); !ok {
+						return "", nil
+					}
+				} else if data, ok := wrap(item.Ok); ok {
+					log.V(2).Info("found changed object", "kind", kind, "id", item.Ok.Id)
+					changedCount++
+					if ok := pipeline.Send(ctx.Done(), changed, data); !ok {
+						return "", nil
+					}
+				}
+			}
+			return "", nil
+		}
+
+		link := delta.Link(kind)
+		deltaLink, err := round(link)
+		if err != nil && link != "" && changedCount+removedCount == 0 && isDeltaLinkExpired(err) {
+			log.Info("warning: stored delta link is no longer valid; listing all objects", "kind", kind)
+			link = ""
+			deltaLink, err = round(link)
+		}
+
+		if err != nil {
+			log.Error(err, "unable to continue processing changed objects", "kind", kind)
+		} else if deltaLink != "" {
+			delta.Update(kind, deltaLink)
+			if ok := pipeline.SendAny(ctx.Done(), removed, AzureWrapper{
+				Kind: enums.KindAZDeltaMarker,
+				Data: models.DeltaMarker{
+					Kind:        kind,
+					Incremental: link != "",
+					TenantId:    tenantId,
+				},
+			}); ok {
+				log.Info("finished listing changed objects", "kind", kind, "changed", changedCount, "removed", removedCount, "incremental", link != "")
+			}
+		}
+	}()
+
+	return changed, removed
+}
+
+// isDeltaLinkExpired reports whether Microsoft Graph rejected a delta link and the round must start over.
+// https://learn.microsoft.com/en-us/graph/delta-query-overview#synchronization-reset
+func isDeltaLinkExpired(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "resyncRequired") || strings.Contains(msg, "syncStateNotFound") || strings.Contains(msg, "syncStateInvalid")
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func testDeltaItem[T any](t *testing.T, data string) client.AzureResult[azure.DeltaItem[T]] {
+	var item azure.DeltaItem[T]
+	if err := json.Unmarshal([]byte(data), &item); err != nil {
+		t.Fatalf("failed to unmarshal delta item: %v", err)
+	}
+	return client.AzureResult[azure.DeltaItem[T]]{Ok: item}
+}
+
+func drainDelta[W any](changed <-chan W, removed <-chan interface{}) ([]W, []AzureWrapper) {
+	var (
+		changedItems []W
+		removedItems []AzureWrapper
+		done         = make(chan struct{})
+	)
+	go func() {
+		defer close(done)
+		for item := range removed {
+			removedItems = append(removedItems, item.(AzureWrapper))
+		}
+	}()
+	for item := range changed {
+		changedItems = append(changedItems, item)
+	}
+	<-done
+	return changedItems, removedItems
+}
+
+func TestListGroupsDelta(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "delta.json")
+	delta, err := loadDeltaState(path, "TENANT")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	delta.Update(enums.KindAZGroup, "https://graph.microsoft.com/v1.0/groups/delta?$deltatoken=1")
+	if err := delta.Save(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if delta, err = loadDeltaState(path, "tenant"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+	mockGroupsChannel := make(chan client.AzureResult[azure.DeltaItem[azure.Group]])
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{TenantId: "tenant"}).AnyTimes()
+	mockClient.EXPECT().ListAzureADGroupsDelta(gomock.Any(), "https://graph.microsoft.com/v1.0/groups/delta?$deltatoken=1", gomock.Any()).Return(mockGroupsChannel).Times(1)
+
+	go func() {
+		defer close(mockGroupsChannel)
+		mockGroupsChannel <- testDeltaItem[azure.Group](t, `{"id":"security","securityEnabled":true}`)
+		mockGroupsChannel <- testDeltaItem[azure.Group](t, `{"id":"renamed","displayName":"Renamed"}`)
+		mockGroupsChannel <- testDeltaItem[azure.Group](t, `{"id":"unified","securityEnabled":false}`)
+		mockGroupsChannel <- testDeltaItem[azure.Group](t, `{"id":"deleted","@removed":{"reason":"changed"}}`)
+		mockGroupsChannel <- client.AzureResult[azure.DeltaItem[azure.Group]]{
+			Ok: azure.DeltaItem[azure.Group]{DeltaLink: "https://graph.microsoft.com/v1.0/groups/delta?$deltatoken=2"},
+		}
+	}()
+
+	changed, removed := drainDelta(listGroupsDelta(ctx, mockClient, delta))
+
+	if len(changed) != 2 {
+		t.Fatalf("got %d changed groups, want 2", len(changed))
+	}
+	for i, id := range []string{"security", "renamed"} {
+		if group := changed[i].(AzureWrapper).Data.(models.Group); group.Id != id {
+			t.Errorf("got changed group %s, want %s", group.Id, id)
+		}
+	}
+
+	if len(removed) != 2 {
+		t.Fatalf("got %d removed objects and markers, want 2", len(removed))
+	}
+	if deleted, ok := removed[0].Data.(models.DeletedObject); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", removed[0].Data, models.DeletedObject{})
+	} else if deleted.Id != "deleted" || deleted.Kind != enums.KindAZGroup || deleted.Reason != azure.DeltaRemovedChanged {
+		t.Errorf("unexpected deleted object: %+v", deleted)
+	}
+	if marker, ok := removed[1].Data.(models.DeltaMarker); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", removed[1].Data, models.DeltaMarker{})
+	} else if !marker.Incremental || marker.Kind != enums.KindAZGroup {
+		t.Errorf("unexpected delta marker: %+v", marker)
+	}
+
+	if err := delta.Save(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if saved, err := loadDeltaState(path, "tenant"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if link := saved.Link(enums.KindAZGroup); link != "https://graph.microsoft.com/v1.0/groups/delta?$deltatoken=2" {
+		t.Errorf("got saved delta link %s", link)
+	}
+}
+
+func TestListUsersDelta_ExpiredLink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	delta, err := loadDeltaState(filepath.Join(t.TempDir(), "delta.json"), "tenant")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	delta.tenants["tenant"] = map[enums.Kind]string{enums.KindAZUser: "expired"}
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+	mockExpiredChannel := make(chan client.AzureResult[azure.DeltaItem[azure.User]])
+	mockUsersChannel := make(chan client.AzureResult[azure.DeltaItem[azure.User]])
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{TenantId: "tenant"}).AnyTimes()
+	mockClient.EXPECT().ListAzureADUsersDelta(gomock.Any(), "expired", gomock.Any()).Return(mockExpiredChannel).Times(1)
+	mockClient.EXPECT().ListAzureADUsersDelta(gomock.Any(), "", gomock.Any()).Return(mockUsersChannel).Times(1)
+
+	go func() {
+		defer close(mockExpiredChannel)
+		mockExpiredChannel <- client.AzureResult[azure.DeltaItem[azure.User]]{
+			Error: fmt.Errorf("map[error:map[code:resyncRequired message:Resync required.]]"),
+		}
+	}()
+	go func() {
+		defer close(mockUsersChannel)
+		mockUsersChannel <- testDeltaItem[azure.User](t, `{"id":"user"}`)
+		mockUsersChannel <- client.AzureResult[azure.DeltaItem[azure.User]]{
+			Ok: azure.DeltaItem[azure.User]{DeltaLink: "fresh"},
+		}
+	}()
+
+	changed, removed := drainDelta(listUsersDelta(ctx, mockClient, delta))
+
+	if len(changed) != 1 {
+		t.Errorf("got %d changed users, want 1", len(changed))
+	}
+	if len(removed) != 1 {
+		t.Fatalf("got %d removed objects and markers, want 1", len(removed))
+	} else if marker := removed[0].Data.(models.DeltaMarker); marker.Incremental {
+		t.Error("expected a full listing after the delta link expired")
+	}
+	if link := delta.pending[enums.KindAZUser]; link != "fresh" {
+		t.Errorf("got pending delta link %s, want fresh", link)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/bloodhoundad/azurehound/v2/client/query"
 	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
 	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
 	"github.com/bloodhoundad/azurehound/v2/pipeline"
 	"github.com/spf13/cobra"
@@ -89,3 +90,19 @@ func listApps(ctx context.Context, client client.AzureClient) <-chan azureWrappe
 
 	return out
 }
+
+func listAppsDelta(ctx context.Context, client client.AzureClient, delta *deltaState) (<-chan azureWrapper[models.App], <-chan interface{}) {
+	return listDelta(ctx, client, delta, enums.KindAZApp,
+		client.ListAzureADAppsDelta, query.GraphParams{},
+		func(item azure.DeltaItem[azure.Application]) (azureWrapper[models.App], bool) {
+			return NewAzureWrapper(
+				enums.KindAZApp,
+				models.App{
+					Application: item.Object,
+					TenantId:    client.TenantInfo().TenantId,
+					TenantName:  client.TenantInfo().DisplayName,
+				},
+			), true
+		},
+	)
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListAutomationAccountRunbooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockAutomationAccountsChannel := make(chan interface{})
+	mockRunbookChannel := make(chan client.AzureResult[azure.AutomationRunbook])
+	mockRunbookChannel2 := make(chan client.AzureResult[azure.AutomationRunbook])
+
+	mockTenant := azure.Tenant{}
+	mockError := fmt.Errorf("I'm an error")
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureAutomationRunbooks(gomock.Any(), gomock.Any()).Return(mockRunbookChannel).Times(1)
+	mockClient.EXPECT().ListAzureAutomationRunbooks(gomock.Any(), gomock.Any()).Return(mockRunbookChannel2).Times(1)
+	channel := listAutomationAccountRunbooks(ctx, mockClient, mockAutomationAccountsChannel)
+
+	go func() {
+		defer close(mockAutomationAccountsChannel)
+		mockAutomationAccountsChannel <- AzureWrapper{
+			Data: models.AutomationAccount{},
+		}
+		mockAutomationAccountsChannel <- AzureWrapper{
+			Data: models.AutomationAccount{},
+		}
+	}()
+	go func() {
+		defer close(mockRunbookChannel)
+		mockRunbookChannel <- client.AzureResult[azure.AutomationRunbook]{
+			Ok: azure.AutomationRunbook{},
+		}
+		mockRunbookChannel <- client.AzureResult[azure.AutomationRunbook]{
+			Ok: azure.AutomationRunbook{},
+		}
+	}()
+	go func() {
+		defer close(mockRunbookChannel2)
+		mockRunbookChannel2 <- client.AzureResult[azure.AutomationRunbook]{
+			Ok: azure.AutomationRunbook{},
+		}
+		mockRunbookChannel2 <- client.AzureResult[azure.AutomationRunbook]{
+			Error: mockError,
+		}
+	}()
+
+	for i := 0; i < 3; i++ {
+		if result, ok := <-channel; !ok {
+			t.Fatalf("failed to receive from channel")
+		} else if wrapper, ok := result.(AzureWrapper); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+		} else if wrapper.Kind != enums.KindAZAutomationRunbook {
+			t.Errorf("unexpected kind: got %s, want %s", wrapper.Kind, enums.KindAZAutomationRunbook)
+		} else if _, ok := wrapper.Data.(models.AutomationRunbook); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.AutomationRunbook{})
+		}
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
 	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
 	"github.com/bloodhoundad/azurehound/v2/pipeline"
 	"github.com/spf13/cobra"
@@ -52,16 +53,21 @@ func listAzureADCmdImpl(cmd *cobra.Command, args []string) {
 
 	log.V(1).Info("testing connections")
 	azClient := connectAndCreateClient()
+	delta, err := openDeltaState(azClient, config.Incremental.Value().(bool))
+	if err != nil {
+		exit(err)
+	}
 	log.Info("collecting azure ad objects...")
 	start := time.Now()
-	stream := listAllAD(ctx, azClient)
+	stream := listAllAD(ctx, azClient, delta)
 	panicrecovery.HandleBubbledPanic(ctx, stop, log)
 	outputStream(ctx, stream)
+	saveDeltaState(ctx, delta)
 	duration := time.Since(start)
 	log.Info("collection completed", "duration", duration.String())
 }
 
-func listAllAD(ctx context.Context, client client.AzureClient) <-chan interface{} {
+func listAllAD(ctx context.Context, client client.AzureClient, delta *deltaState) <-chan interface{} {
 	var (
 		devices = make(chan interface{})
 
@@ -79,8 +85,12 @@ func listAllAD(ctx context.Context, client client.AzureClient) <-chan interface{
 		tenants = make(chan interface{})
 	)
 
+	// Enumerate Apps, Groups, ServicePrincipals and Users, either all of them or only those changed since the previous
+	// incremental collection
+	objects := listDirectoryObjects(ctx, client, delta)
+
 	// Enumerate Apps, AppOwners and AppMembers
-	appChans := pipeline.TeeFixed(ctx.Done(), listApps(ctx, client), 3)
+	appChans := pipeline.TeeFixed(ctx.Done(), objects.apps, 3)
 	apps := pipeline.ToAny(ctx.Done(), appChans[0])
 	appOwners := pipeline.ToAny(ctx.Done(), listAppOwners(ctx, client, appChans[1]))
 	appFICs := pipeline.ToAny(ctx.Done(), listAppFICs(ctx, client, appChans[2]))
@@ -89,19 +99,19 @@ func listAllAD(ctx context.Context, client client.AzureClient) <-chan interface{
 	pipeline.Tee(ctx.Done(), listDevices(ctx, client), devices)
 
 	// Enumerate Groups, GroupOwners and GroupMembers
-	pipeline.Tee(ctx.Done(), listGroups(ctx, client), groups, groups2, groups3)
+	pipeline.Tee(ctx.Done(), objects.groups, groups, groups2, groups3)
 	groupOwners := listGroupOwners(ctx, client, groups2)
 	groupMembers := listGroupMembers(ctx, client, groups3)
 
 	// Enumerate ServicePrincipals and ServicePrincipalOwners
-	pipeline.Tee(ctx.Done(), listServicePrincipals(ctx, client), servicePrincipals, servicePrincipals2, servicePrincipals3)
+	pipeline.Tee(ctx.Done(), objects.servicePrincipals, servicePrincipals, servicePrincipals2, servicePrincipals3)
 	servicePrincipalOwners := listServicePrincipalOwners(ctx, client, servicePrincipals2)
 
 	// Enumerate Tenants
 	pipeline.Tee(ctx.Done(), listTenants(ctx, client), tenants)
 
 	// Enumerate Users
-	users := listUsers(ctx, client)
+	users := objects.users
 
 	// Enumerate Roles and RoleAssignments
 	pipeline.Tee(ctx.Done(), listRoles(ctx, client), roles, roles2)
@@ -116,7 +126,7 @@ func listAllAD(ctx context.Context, client client.AzureClient) <-chan interface{
 	// Enumerate Role Management Policy Assignments
 	unifiedRoleManagementPolicyAssignments := listRoleAssignmentPolicies(ctx, client)
 
-	return pipeline.Mux(ctx.Done(),
+	streams := []<-chan interface{}{
 		appOwners,
 		appFICs,
 		appRoleAssignments,
@@ -133,5 +143,11 @@ func listAllAD(ctx context.Context, client client.AzureClient) <-chan interface{
 		users,
 		unifiedRoleEligibilitySchedules,
 		unifiedRoleManagementPolicyAssignments,
-	)
+	}
+
+	if objects.removed != nil {
+		streams = append(streams, objects.removed)
+	}
+
+	return pipeline.Mux(ctx.Done(), streams...)
 }
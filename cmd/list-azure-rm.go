@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
 	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models"
 	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
@@ -70,18 +71,52 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 
 		webApps  = make(chan interface{})
 		webApps2 = make(chan interface{})
+		webApps3 = make(chan interface{})
 
 		automationAccounts  = make(chan interface{})
 		automationAccounts2 = make(chan interface{})
+		automationAccounts3 = make(chan interface{})
+		automationAccounts4 = make(chan interface{})
+		automationAccounts5 = make(chan interface{})
+		automationAccounts6 = make(chan interface{})
+
+		billingAccounts  = make(chan interface{})
+		billingAccounts2 = make(chan interface{})
+		billingAccounts3 = make(chan interface{})
+		billingProfiles  = make(chan interface{})
+		billingProfiles2 = make(chan interface{})
+		billingProfiles3 = make(chan interface{})
+		invoiceSections  = make(chan interface{})
+		invoiceSections2 = make(chan interface{})
+
+		cognitiveServicesAccounts  = make(chan interface{})
+		cognitiveServicesAccounts2 = make(chan interface{})
 
 		containerRegistries  = make(chan interface{})
 		containerRegistries2 = make(chan interface{})
+		containerRegistries3 = make(chan interface{})
+		containerRegistries4 = make(chan interface{})
+		containerRegistries5 = make(chan interface{})
+		containerRegistries6 = make(chan interface{})
+
+		containerRegistryRoleAssignments1 = make(chan interface{})
+		containerRegistryRoleAssignments2 = make(chan interface{})
 
 		logicApps  = make(chan interface{})
 		logicApps2 = make(chan interface{})
+		logicApps3 = make(chan interface{})
+
+		machineLearningWorkspaces  = make(chan interface{})
+		machineLearningWorkspaces2 = make(chan interface{})
+		machineLearningWorkspaces3 = make(chan interface{})
 
 		managedClusters  = make(chan interface{})
 		managedClusters2 = make(chan interface{})
+		managedClusters3 = make(chan interface{})
+		managedClusters4 = make(chan interface{})
+
+		resources  = make(chan interface{})
+		resources2 = make(chan interface{})
 
 		vmScaleSets  = make(chan interface{})
 		vmScaleSets2 = make(chan interface{})
@@ -89,6 +124,9 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 		keyVaults                = make(chan interface{})
 		keyVaults2               = make(chan interface{})
 		keyVaults3               = make(chan interface{})
+		keyVaults4               = make(chan interface{})
+		keyVaults5               = make(chan interface{})
+		keyVaults6               = make(chan interface{})
 		keyVaultRoleAssignments1 = make(chan azureWrapper[models.KeyVaultRoleAssignments])
 		keyVaultRoleAssignments2 = make(chan azureWrapper[models.KeyVaultRoleAssignments])
 		keyVaultRoleAssignments3 = make(chan azureWrapper[models.KeyVaultRoleAssignments])
@@ -97,6 +135,8 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 		mgmtGroups                = make(chan interface{})
 		mgmtGroups2               = make(chan interface{})
 		mgmtGroups3               = make(chan interface{})
+		mgmtGroups4               = make(chan interface{})
+		mgmtGroups5               = make(chan interface{})
 		mgmtGroupRoleAssignments1 = make(chan azureWrapper[models.ManagementGroupRoleAssignments])
 		mgmtGroupRoleAssignments2 = make(chan azureWrapper[models.ManagementGroupRoleAssignments])
 		mgmtGroupRoleAssignments3 = make(chan azureWrapper[models.ManagementGroupRoleAssignments])
@@ -119,12 +159,23 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 		subscriptions10              = make(chan interface{})
 		subscriptions11              = make(chan interface{})
 		subscriptions12              = make(chan interface{})
+		subscriptions13              = make(chan interface{})
+		subscriptions14              = make(chan interface{})
+		subscriptions15              = make(chan interface{})
+		subscriptions16              = make(chan interface{})
+		subscriptions17              = make(chan interface{})
+		subscriptions18              = make(chan interface{})
+		subscriptions19              = make(chan interface{})
+		subscriptions20              = make(chan interface{})
+		subscriptions21              = make(chan interface{})
+		subscriptions22              = make(chan interface{})
 		subscriptionRoleAssignments1 = make(chan interface{})
 		subscriptionRoleAssignments2 = make(chan interface{})
 		subscriptionRoleAssignments3 = make(chan interface{})
 
 		virtualMachines                = make(chan interface{})
 		virtualMachines2               = make(chan interface{})
+		virtualMachines3               = make(chan interface{})
 		virtualMachineRoleAssignments1 = make(chan azureWrapper[models.VirtualMachineRoleAssignments])
 		virtualMachineRoleAssignments2 = make(chan azureWrapper[models.VirtualMachineRoleAssignments])
 		virtualMachineRoleAssignments3 = make(chan azureWrapper[models.VirtualMachineRoleAssignments])
@@ -132,8 +183,11 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 		virtualMachineRoleAssignments5 = make(chan azureWrapper[models.VirtualMachineRoleAssignments])
 	)
 
+	// Resources are listed per subscription and type, or through Resource Graph queries across all subscriptions
+	listers := newSubscriptionResourceListers(config.AzUseResourceGraph.Value().(bool))
+
 	// Enumerate entities
-	pipeline.Tee(ctx.Done(), listManagementGroups(ctx, client), mgmtGroups, mgmtGroups2, mgmtGroups3)
+	pipeline.Tee(ctx.Done(), listManagementGroups(ctx, client), mgmtGroups, mgmtGroups2, mgmtGroups3, mgmtGroups4, mgmtGroups5)
 	pipeline.Tee(ctx.Done(), listSubscriptions(ctx, client),
 		subscriptions,
 		subscriptions2,
@@ -147,17 +201,51 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 		subscriptions10,
 		subscriptions11,
 		subscriptions12,
+		subscriptions13,
+		subscriptions14,
+		subscriptions15,
+		subscriptions16,
+		subscriptions17,
+		subscriptions18,
+		subscriptions19,
+		subscriptions20,
+		subscriptions21,
+		subscriptions22,
 	)
-	pipeline.Tee(ctx.Done(), listResourceGroups(ctx, client, subscriptions2), resourceGroups, resourceGroups2)
-	pipeline.Tee(ctx.Done(), listKeyVaults(ctx, client, subscriptions3), keyVaults, keyVaults2, keyVaults3)
-	pipeline.Tee(ctx.Done(), listVirtualMachines(ctx, client, subscriptions4), virtualMachines, virtualMachines2)
-	pipeline.Tee(ctx.Done(), listFunctionApps(ctx, client, subscriptions6), functionApps, functionApps2)
-	pipeline.Tee(ctx.Done(), listWebApps(ctx, client, subscriptions7), webApps, webApps2)
-	pipeline.Tee(ctx.Done(), listAutomationAccounts(ctx, client, subscriptions8), automationAccounts, automationAccounts2)
-	pipeline.Tee(ctx.Done(), listContainerRegistries(ctx, client, subscriptions9), containerRegistries, containerRegistries2)
-	pipeline.Tee(ctx.Done(), listLogicApps(ctx, client, subscriptions10), logicApps, logicApps2)
-	pipeline.Tee(ctx.Done(), listManagedClusters(ctx, client, subscriptions11), managedClusters, managedClusters2)
-	pipeline.Tee(ctx.Done(), listVMScaleSets(ctx, client, subscriptions12), vmScaleSets, vmScaleSets2)
+	pipeline.Tee(ctx.Done(), listers.resourceGroups(ctx, client, subscriptions2), resourceGroups, resourceGroups2)
+	if config.AzKeyVaultDataPlane.Value().(bool) {
+		pipeline.Tee(ctx.Done(), listers.keyVaults(ctx, client, subscriptions3), keyVaults, keyVaults2, keyVaults3, keyVaults4, keyVaults5, keyVaults6)
+	} else {
+		pipeline.Tee(ctx.Done(), listers.keyVaults(ctx, client, subscriptions3), keyVaults, keyVaults2, keyVaults3)
+	}
+	pipeline.Tee(ctx.Done(), listers.virtualMachines(ctx, client, subscriptions4), virtualMachines, virtualMachines2, virtualMachines3)
+	pipeline.Tee(ctx.Done(), listers.functionApps(ctx, client, subscriptions6), functionApps, functionApps2)
+	pipeline.Tee(ctx.Done(), listers.webApps(ctx, client, subscriptions7), webApps, webApps2, webApps3)
+	pipeline.Tee(ctx.Done(), listers.automationAccounts(ctx, client, subscriptions8),
+		automationAccounts,
+		automationAccounts2,
+		automationAccounts3,
+		automationAccounts4,
+		automationAccounts5,
+		automationAccounts6,
+	)
+	pipeline.Tee(ctx.Done(), listers.containerRegistries(ctx, client, subscriptions9),
+		containerRegistries,
+		containerRegistries2,
+		containerRegistries3,
+		containerRegistries4,
+		containerRegistries5,
+		containerRegistries6,
+	)
+	pipeline.Tee(ctx.Done(), listers.logicApps(ctx, client, subscriptions10), logicApps, logicApps2, logicApps3)
+	if config.AzManagedClusterRBAC.Value().(bool) {
+		pipeline.Tee(ctx.Done(), listers.managedClusters(ctx, client, subscriptions11), managedClusters, managedClusters2, managedClusters3, managedClusters4)
+	} else {
+		pipeline.Tee(ctx.Done(), listers.managedClusters(ctx, client, subscriptions11), managedClusters, managedClusters2, managedClusters3)
+	}
+	pipeline.Tee(ctx.Done(), listers.vmScaleSets(ctx, client, subscriptions12), vmScaleSets, vmScaleSets2)
+	pipeline.Tee(ctx.Done(), listers.cognitiveServicesAccounts(ctx, client, subscriptions16), cognitiveServicesAccounts, cognitiveServicesAccounts2)
+	pipeline.Tee(ctx.Done(), listers.machineLearningWorkspaces(ctx, client, subscriptions17), machineLearningWorkspaces, machineLearningWorkspaces2, machineLearningWorkspaces3)
 
 	// Enumerate Relationships
 	// ManagementGroups: Descendants, Owners, Contributors and UserAccessAdmins
@@ -173,6 +261,9 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 	subscriptionContributors := listSubscriptionContributors(ctx, client, subscriptionRoleAssignments2)
 	subscriptionUserAccessAdmins := listSubscriptionUserAccessAdmins(ctx, client, subscriptionRoleAssignments3)
 
+	// Subscriptions: Classic Administrators and Co-Administrators
+	subscriptionClassicAdmins := listSubscriptionClassicAdmins(ctx, client, subscriptions18)
+
 	// ResourceGroups: Owners, Contributors and UserAccessAdmins
 	pipeline.Tee(ctx.Done(), listResourceGroupRoleAssignments(ctx, client, resourceGroups2), resourceGroupRoleAssignments1, resourceGroupRoleAssignments2, resourceGroupRoleAssignments3)
 	resourceGroupOwners := listResourceGroupOwners(ctx, resourceGroupRoleAssignments1)
@@ -195,6 +286,9 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 	virtualMachineAdminLogins := listVirtualMachineAdminLogins(ctx, virtualMachineRoleAssignments4)
 	virtualMachineUserAccessAdmins := listVirtualMachineUserAccessAdmins(ctx, virtualMachineRoleAssignments5)
 
+	// VirtualMachines: Just-in-time access policies
+	virtualMachineJitPolicies := listVirtualMachineJitPolicies(ctx, client, subscriptions22, virtualMachines3)
+
 	// Enumerate Function App Role Assignments
 	functionAppRoleAssignments := listFunctionAppRoleAssignments(ctx, client, functionApps2)
 
@@ -204,33 +298,96 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 	// Enumerate Automation Account Role Assignments
 	automationAccountRoleAssignments := listAutomationAccountRoleAssignments(ctx, client, automationAccounts2)
 
+	// Automation Accounts: Runbooks, Credentials, Variables and Hybrid Worker Groups
+	automationAccountRunbooks := listAutomationAccountRunbooks(ctx, client, automationAccounts3)
+	automationAccountCredentials := listAutomationAccountCredentials(ctx, client, automationAccounts4)
+	automationAccountVariables := listAutomationAccountVariables(ctx, client, automationAccounts5)
+	automationAccountHybridWorkerGroups := listAutomationAccountHybridWorkerGroups(ctx, client, automationAccounts6)
+
 	// Enumerate Container Registry Role Assignments
-	containerRegistryRoleAssignments := listContainerRegistryRoleAssignments(ctx, client, containerRegistries2)
+	pipeline.Tee(ctx.Done(), listContainerRegistryRoleAssignments(ctx, client, containerRegistries2), containerRegistryRoleAssignments1, containerRegistryRoleAssignments2)
+
+	// Container Registries: Tokens, Scope Maps, Webhooks and the Web Apps and Managed Clusters that pull from them
+	containerRegistryTokens := listContainerRegistryTokens(ctx, client, containerRegistries3)
+	containerRegistryScopeMaps := listContainerRegistryScopeMaps(ctx, client, containerRegistries4)
+	containerRegistryWebhooks := listContainerRegistryWebhooks(ctx, client, containerRegistries5)
+	containerRegistryPullers := listContainerRegistryPullers(ctx, client, containerRegistries6, containerRegistryRoleAssignments2, webApps3, managedClusters3)
 
 	// Enumerate Logic Apps Role Assignments
 	logicAppRoleAssignments := listLogicAppRoleAssignments(ctx, client, logicApps2)
 
+	// Enumerate API Connections and the Logic Apps that use them
+	apiConnections := listApiConnections(ctx, client, subscriptions13)
+	logicAppApiConnections := listLogicAppApiConnections(ctx, client, logicApps3)
+
+	// Enumerate Resources of any other type with a Managed Identity, and their Role Assignments
+	pipeline.Tee(ctx.Done(), listResources(ctx, client, subscriptions15), resources, resources2)
+	resourceRoleAssignments := listResourceRoleAssignments(ctx, client, resources2)
+
+	// Enumerate Policy Definitions and Assignments at Management Group and Subscription scope
+	policyDefinitions := listPolicyDefinitions(ctx, client, pipeline.Mux(ctx.Done(), mgmtGroups4, subscriptions19))
+	policyAssignments := listPolicyAssignments(ctx, client, pipeline.Mux(ctx.Done(), mgmtGroups5, subscriptions20))
+
+	// Enumerate Billing Accounts, Billing Profiles, Invoice Sections and their Role Assignments
+	pipeline.Tee(ctx.Done(), listBillingAccounts(ctx, client), billingAccounts, billingAccounts2, billingAccounts3)
+	pipeline.Tee(ctx.Done(), listBillingProfiles(ctx, client, billingAccounts2), billingProfiles, billingProfiles2, billingProfiles3)
+	pipeline.Tee(ctx.Done(), listInvoiceSections(ctx, client, billingProfiles2), invoiceSections, invoiceSections2)
+	billingRoleAssignments := listBillingRoleAssignments(ctx, client, pipeline.Mux(ctx.Done(), billingAccounts3, billingProfiles3, invoiceSections2))
+
+	// Enumerate Resource Locks on Subscriptions, Resource Groups and Resources
+	resourceLocks := listResourceLocks(ctx, client, subscriptions21)
+
+	// Enumerate Lighthouse Delegations to managing tenants
+	lighthouseDelegations := listLighthouseDelegations(ctx, client, subscriptions14)
+
+	// Enumerate Cognitive Services Account Role Assignments
+	cognitiveServicesAccountRoleAssignments := listCognitiveServicesAccountRoleAssignments(ctx, client, cognitiveServicesAccounts2)
+
+	// Machine Learning Workspaces: Role Assignments and Compute Instances
+	machineLearningWorkspaceRoleAssignments := listMachineLearningWorkspaceRoleAssignments(ctx, client, machineLearningWorkspaces2)
+	machineLearningComputeInstances := listMachineLearningComputeInstances(ctx, client, machineLearningWorkspaces3)
+
 	// Enumerate Managed Cluster Role Assignments
 	managedClusterRoleAssignments := listManagedClusterRoleAssignments(ctx, client, managedClusters2)
 
 	// Enumerate VM Scale Set Role Assignments
 	vmScaleSetRoleAssignments := listVMScaleSetRoleAssignments(ctx, client, vmScaleSets2)
 
-	return pipeline.Mux(ctx.Done(),
+	streams := []<-chan interface{}{
+		apiConnections,
 		automationAccounts,
 		automationAccountRoleAssignments,
+		automationAccountRunbooks,
+		automationAccountCredentials,
+		automationAccountVariables,
+		automationAccountHybridWorkerGroups,
+		billingAccounts,
+		billingProfiles,
+		billingRoleAssignments,
+		cognitiveServicesAccounts,
+		cognitiveServicesAccountRoleAssignments,
 		containerRegistries,
-		containerRegistryRoleAssignments,
+		containerRegistryRoleAssignments1,
+		containerRegistryTokens,
+		containerRegistryScopeMaps,
+		containerRegistryWebhooks,
+		containerRegistryPullers,
 		functionApps,
 		functionAppRoleAssignments,
+		invoiceSections,
 		keyVaultAccessPolicies,
 		keyVaultContributors,
 		keyVaultKVContributors,
 		keyVaultOwners,
 		keyVaultUserAccessAdmins,
 		keyVaults,
+		lighthouseDelegations,
 		logicApps,
 		logicAppRoleAssignments,
+		logicAppApiConnections,
+		machineLearningWorkspaces,
+		machineLearningWorkspaceRoleAssignments,
+		machineLearningComputeInstances,
 		managedClusters,
 		managedClusterRoleAssignments,
 		mgmtGroupContributors,
@@ -238,10 +395,16 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 		mgmtGroupOwners,
 		mgmtGroupUserAccessAdmins,
 		mgmtGroups,
+		policyAssignments,
+		policyDefinitions,
 		resourceGroupContributors,
 		resourceGroupOwners,
 		resourceGroupUserAccessAdmins,
 		resourceGroups,
+		resourceLocks,
+		resourceRoleAssignments,
+		resources,
+		subscriptionClassicAdmins,
 		subscriptionContributors,
 		subscriptionOwners,
 		subscriptionUserAccessAdmins,
@@ -249,6 +412,7 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 		virtualMachineAdminLogins,
 		virtualMachineAvereContributors,
 		virtualMachineContributors,
+		virtualMachineJitPolicies,
 		virtualMachineOwners,
 		virtualMachineUserAccessAdmins,
 		virtualMachines,
@@ -256,5 +420,21 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 		vmScaleSetRoleAssignments,
 		webApps,
 		webAppRoleAssignments,
-	)
+	}
+
+	// KeyVaults: Secrets, Keys and Certificates (opt-in, metadata only)
+	if config.AzKeyVaultDataPlane.Value().(bool) {
+		streams = append(streams,
+			listKeyVaultSecrets(ctx, client, keyVaults4),
+			listKeyVaultKeys(ctx, client, keyVaults5),
+			listKeyVaultCertificates(ctx, client, keyVaults6),
+		)
+	}
+
+	// Managed Clusters: Kubernetes role bindings (opt-in, requires cluster admin or user credentials)
+	if config.AzManagedClusterRBAC.Value().(bool) {
+		streams = append(streams, listManagedClusterRoleBindings(ctx, client, managedClusters4))
+	}
+
+	return pipeline.Mux(ctx.Done(), streams...)
 }
@@ -0,0 +1,90 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listBillingAccountsCmd)
+}
+
+var listBillingAccountsCmd = &cobra.Command{
+	Use:          "billing-accounts",
+	Long:         "Lists Azure Billing Accounts",
+	Run:          listBillingAccountsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listBillingAccountsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure billing accounts...")
+	start := time.Now()
+	stream := listBillingAccounts(ctx, azClient)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listBillingAccounts(ctx context.Context, client client.AzureClient) <-chan interface{} {
+	out := make(chan interface{})
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(out)
+		count := 0
+		for item := range client.ListAzureBillingAccounts(ctx) {
+			if item.Error != nil {
+				log.Info("warning: unable to process azure billing accounts; either the organization has no billing accounts or azurehound does not have a billing role on them.")
+				return
+			} else {
+				billingAccount := models.BillingAccount{
+					BillingAccount: item.Ok,
+					TenantId:       client.TenantInfo().TenantId,
+				}
+				log.V(2).Info("found billing account", "name", billingAccount.Name, "agreementType", billingAccount.Properties.AgreementType)
+				count++
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZBillingAccount,
+					Data: billingAccount,
+				}); !ok {
+					return
+				}
+			}
+		}
+		log.Info("finished listing all billing accounts", "count", count)
+	}()
+
+	return out
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listBillingProfilesCmd)
+}
+
+var listBillingProfilesCmd = &cobra.Command{
+	Use:          "billing-profiles",
+	Long:         "Lists Azure Billing Profiles",
+	Run:          listBillingProfilesCmdImpl,
+	SilenceUsage: true,
+}
+
+func listBillingProfilesCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure billing profiles...")
+	start := time.Now()
+	stream := listBillingProfiles(ctx, azClient, listBillingAccounts(ctx, azClient))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listBillingProfiles(ctx context.Context, client client.AzureClient, billingAccounts <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan interface{})
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		for result := range pipeline.OrDone(ctx.Done(), billingAccounts) {
+			if billingAccount, ok := result.(AzureWrapper).Data.(models.BillingAccount); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating billing profiles", "result", result)
+				return
+			} else if !billingAccount.HasBillingProfiles() {
+				log.V(2).Info("skipping billing account without billing profiles", "name", billingAccount.Name, "agreementType", billingAccount.Properties.AgreementType)
+			} else {
+				if ok := pipeline.SendAny(ctx.Done(), ids, billingAccount); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for result := range stream {
+				var (
+					billingAccount = result.(models.BillingAccount)
+					count          = 0
+				)
+				for item := range client.ListAzureBillingProfiles(ctx, billingAccount.Id) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing billing profiles for this billing account", "billingAccountId", billingAccount.Id)
+					} else {
+						billingProfile := models.BillingProfile{
+							BillingAccountProfile: item.Ok,
+							BillingAccountId:      billingAccount.Id,
+							TenantId:              client.TenantInfo().TenantId,
+						}
+						log.V(2).Info("found billing profile", "name", billingProfile.Name)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZBillingProfile,
+							Data: billingProfile,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing billing profiles", "billingAccountId", billingAccount.Id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all billing profiles")
+	}()
+
+	return out
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListBillingProfiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockBillingAccountsChannel := make(chan interface{})
+	mockBillingProfilesChannel := make(chan client.AzureResult[azure.BillingAccountProfile])
+
+	mockTenant := azure.Tenant{}
+	mockError := fmt.Errorf("I'm an error")
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureBillingProfiles(gomock.Any(), "mca").Return(mockBillingProfilesChannel).Times(1)
+	channel := listBillingProfiles(ctx, mockClient, mockBillingAccountsChannel)
+
+	go func() {
+		defer close(mockBillingAccountsChannel)
+		mockBillingAccountsChannel <- AzureWrapper{
+			Data: models.BillingAccount{
+				BillingAccount: azure.BillingAccount{
+					Entity:     azure.Entity{Id: "ea"},
+					Properties: azure.BillingAccountProperties{AgreementType: "EnterpriseAgreement"},
+				},
+			},
+		}
+		mockBillingAccountsChannel <- AzureWrapper{
+			Data: models.BillingAccount{
+				BillingAccount: azure.BillingAccount{
+					Entity:     azure.Entity{Id: "mca"},
+					Properties: azure.BillingAccountProperties{AgreementType: azure.MicrosoftCustomerAgreement},
+				},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockBillingProfilesChannel)
+		mockBillingProfilesChannel <- client.AzureResult[azure.BillingAccountProfile]{
+			Ok: azure.BillingAccountProfile{},
+		}
+		mockBillingProfilesChannel <- client.AzureResult[azure.BillingAccountProfile]{
+			Error: mockError,
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if wrapper.Kind != enums.KindAZBillingProfile {
+		t.Errorf("unexpected kind: got %s, want %s", wrapper.Kind, enums.KindAZBillingProfile)
+	} else if data, ok := wrapper.Data.(models.BillingProfile); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.BillingProfile{})
+	} else if data.BillingAccountId != "mca" {
+		t.Errorf("unexpected billing account id: got %s, want %s", data.BillingAccountId, "mca")
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
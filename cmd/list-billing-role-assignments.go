@@ -0,0 +1,147 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listBillingRoleAssignmentsCmd)
+}
+
+var listBillingRoleAssignmentsCmd = &cobra.Command{
+	Use:          "billing-role-assignments",
+	Long:         "Lists Azure Billing Role Assignments on billing accounts, billing profiles and invoice sections",
+	Run:          listBillingRoleAssignmentsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listBillingRoleAssignmentsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure billing role assignments...")
+	start := time.Now()
+
+	var (
+		billingAccounts  = make(chan interface{})
+		billingAccounts2 = make(chan interface{})
+		billingProfiles  = make(chan interface{})
+		billingProfiles2 = make(chan interface{})
+	)
+	pipeline.Tee(ctx.Done(), listBillingAccounts(ctx, azClient), billingAccounts, billingAccounts2)
+	pipeline.Tee(ctx.Done(), listBillingProfiles(ctx, azClient, billingAccounts2), billingProfiles, billingProfiles2)
+	invoiceSections := listInvoiceSections(ctx, azClient, billingProfiles2)
+
+	stream := listBillingRoleAssignments(ctx, azClient, pipeline.Mux(ctx.Done(), billingAccounts, billingProfiles, invoiceSections))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listBillingRoleAssignments lists the billing role assignments of every billing account, billing profile and invoice
+// section received on billingScopes.
+func listBillingRoleAssignments(ctx context.Context, client client.AzureClient, billingScopes <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan string)
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		for result := range pipeline.OrDone(ctx.Done(), billingScopes) {
+			var id string
+			switch billingScope := result.(AzureWrapper).Data.(type) {
+			case models.BillingAccount:
+				id = billingScope.Id
+			case models.BillingProfile:
+				id = billingScope.Id
+			case models.InvoiceSection:
+				id = billingScope.Id
+			default:
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating billing role assignments", "result", result)
+				return
+			}
+
+			if ok := pipeline.Send(ctx.Done(), ids, id); !ok {
+				return
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				count := 0
+				for item := range client.ListAzureBillingRoleAssignments(ctx, id) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing role assignments for this billing scope", "billingScopeId", id)
+					} else {
+						billingRoleAssignment := models.BillingRoleAssignment{
+							BillingRoleAssignment: item.Ok,
+							BillingScopeId:        id,
+							TenantId:              client.TenantInfo().TenantId,
+						}
+						log.V(2).Info("found billing role assignment", "principalId", billingRoleAssignment.Properties.PrincipalId, "roleDefinitionId", billingRoleAssignment.Properties.RoleDefinitionId)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZBillingRoleAssignment,
+							Data: billingRoleAssignment,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing billing role assignments", "billingScopeId", id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all billing role assignments")
+	}()
+
+	return out
+}
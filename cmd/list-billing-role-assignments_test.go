@@ -0,0 +1,97 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListBillingRoleAssignments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockBillingScopesChannel := make(chan interface{})
+	mockTenant := azure.Tenant{}
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+
+	scopes := []interface{}{
+		models.BillingAccount{BillingAccount: azure.BillingAccount{Entity: azure.Entity{Id: "account"}}},
+		models.BillingProfile{BillingAccountProfile: azure.BillingAccountProfile{Entity: azure.Entity{Id: "profile"}}},
+		models.InvoiceSection{InvoiceSection: azure.InvoiceSection{Entity: azure.Entity{Id: "section"}}},
+	}
+	for _, id := range []string{"account", "profile", "section"} {
+		mockChannel := make(chan client.AzureResult[azure.BillingRoleAssignment])
+		mockClient.EXPECT().ListAzureBillingRoleAssignments(gomock.Any(), id).Return(mockChannel).Times(1)
+		go func(id string) {
+			defer close(mockChannel)
+			mockChannel <- client.AzureResult[azure.BillingRoleAssignment]{
+				Ok: azure.BillingRoleAssignment{
+					Properties: azure.BillingRoleAssignmentProperties{PrincipalId: "principal", Scope: id},
+				},
+			}
+		}(id)
+	}
+	channel := listBillingRoleAssignments(ctx, mockClient, mockBillingScopesChannel)
+
+	go func() {
+		defer close(mockBillingScopesChannel)
+		for _, scope := range scopes {
+			mockBillingScopesChannel <- AzureWrapper{Data: scope}
+		}
+	}()
+
+	seen := map[string]bool{}
+	for i := 0; i < len(scopes); i++ {
+		if result, ok := <-channel; !ok {
+			t.Fatalf("failed to receive from channel")
+		} else if wrapper, ok := result.(AzureWrapper); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+		} else if wrapper.Kind != enums.KindAZBillingRoleAssignment {
+			t.Errorf("unexpected kind: got %s, want %s", wrapper.Kind, enums.KindAZBillingRoleAssignment)
+		} else if data, ok := wrapper.Data.(models.BillingRoleAssignment); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.BillingRoleAssignment{})
+		} else if data.BillingScopeId != data.Properties.Scope {
+			t.Errorf("unexpected billing scope id: got %s, want %s", data.BillingScopeId, data.Properties.Scope)
+		} else {
+			seen[data.BillingScopeId] = true
+		}
+	}
+
+	if len(seen) != len(scopes) {
+		t.Errorf("got role assignments for %v billing scopes, want %v", len(seen), len(scopes))
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listCognitiveServicesAccountRoleAssignment)
+}
+
+var listCognitiveServicesAccountRoleAssignment = &cobra.Command{
+	Use:          "cognitive-services-account-role-assignments",
+	Long:         "Lists Azure Cognitive Services Account Role Assignments",
+	Run:          listCognitiveServicesAccountRoleAssignmentImpl,
+	SilenceUsage: true,
+}
+
+func listCognitiveServicesAccountRoleAssignmentImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	if err := testConnections(); err != nil {
+		exit(err)
+	} else if azClient, err := newAzureClient(); err != nil {
+		exit(err)
+	} else {
+		log.Info("collecting azure cognitive services account role assignments...")
+		start := time.Now()
+		subscriptions := listSubscriptions(ctx, azClient)
+		stream := listCognitiveServicesAccountRoleAssignments(ctx, azClient, listCognitiveServicesAccounts(ctx, azClient, subscriptions))
+		panicrecovery.HandleBubbledPanic(ctx, stop, log)
+		outputStream(ctx, stream)
+		duration := time.Since(start)
+		log.Info("collection completed", "duration", duration.String())
+	}
+}
+
+func listCognitiveServicesAccountRoleAssignments(ctx context.Context, client client.AzureClient, cognitiveServicesAccounts <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan string)
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		for result := range pipeline.OrDone(ctx.Done(), cognitiveServicesAccounts) {
+			if cognitiveServicesAccount, ok := result.(AzureWrapper).Data.(models.CognitiveServicesAccount); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating cognitive services account role assignments", "result", result)
+				return
+			} else {
+				if ok := pipeline.Send(ctx.Done(), ids, cognitiveServicesAccount.Id); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				var (
+					cognitiveServicesAccountRoleAssignments = models.AzureRoleAssignments{
+						ObjectId: id,
+					}
+					count = 0
+				)
+				for item := range client.ListRoleAssignmentsForResource(ctx, id, "", "") {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing role assignments for this cognitive services account", "cognitiveServicesAccountId", id)
+					} else {
+						roleDefinitionId := path.Base(item.Ok.Properties.RoleDefinitionId)
+
+						cognitiveServicesAccountRoleAssignment := models.AzureRoleAssignment{
+							Assignee:         item.Ok,
+							ObjectId:         id,
+							RoleDefinitionId: roleDefinitionId,
+						}
+						log.V(2).Info("found cognitive services account role assignment", "roleDefinitionId", roleDefinitionId)
+						count++
+						cognitiveServicesAccountRoleAssignments.RoleAssignments = append(cognitiveServicesAccountRoleAssignments.RoleAssignments, cognitiveServicesAccountRoleAssignment)
+					}
+				}
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZCognitiveServicesRoleAssignment,
+					Data: cognitiveServicesAccountRoleAssignments,
+				}); !ok {
+					return
+				}
+				log.V(1).Info("finished listing cognitive services account role assignments", "cognitiveServicesAccountId", id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all cognitive services account role assignments")
+	}()
+
+	return out
+}
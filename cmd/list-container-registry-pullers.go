@@ -0,0 +1,218 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listContainerRegistryPullersCmd)
+}
+
+var listContainerRegistryPullersCmd = &cobra.Command{
+	Use:          "container-registry-pullers",
+	Long:         "Lists Azure Web Apps and Managed Clusters that pull images from Azure Container Registries",
+	Run:          listContainerRegistryPullersCmdImpl,
+	SilenceUsage: true,
+}
+
+func listContainerRegistryPullersCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure container registry pullers...")
+	start := time.Now()
+	var (
+		subscriptions        = make(chan interface{})
+		subscriptions2       = make(chan interface{})
+		subscriptions3       = make(chan interface{})
+		containerRegistries  = make(chan interface{})
+		containerRegistries2 = make(chan interface{})
+	)
+	pipeline.Tee(ctx.Done(), listSubscriptions(ctx, azClient), subscriptions, subscriptions2, subscriptions3)
+	pipeline.Tee(ctx.Done(), listContainerRegistries(ctx, azClient, subscriptions), containerRegistries, containerRegistries2)
+	stream := listContainerRegistryPullers(ctx, azClient,
+		containerRegistries,
+		listContainerRegistryRoleAssignments(ctx, azClient, containerRegistries2),
+		listWebApps(ctx, azClient, subscriptions2),
+		listManagedClusters(ctx, azClient, subscriptions3),
+	)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listContainerRegistryPullers links registries to the web apps that run images from them and to the managed clusters
+// whose kubelet identity holds a role on them, as found in the registry role assignments. All four inputs are drained
+// concurrently so that upstream tees sharing a source never block on one another, keeping only the few identifiers
+// needed to link them.
+func listContainerRegistryPullers(ctx context.Context, client client.AzureClient, containerRegistries, containerRegistryRoleAssignments, webApps, managedClusters <-chan interface{}) <-chan interface{} {
+	var (
+		out             = make(chan interface{})
+		loginServers    = make(map[string]string)
+		registries      = make(map[string]string)
+		sites           []models.ContainerRegistryPuller
+		kubeletIdentity = make(map[string]models.ContainerRegistryPuller)
+		assignments     []models.ContainerRegistryPuller
+		wg              sync.WaitGroup
+	)
+
+	wg.Add(4)
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer wg.Done()
+		for _, registry := range collectAzureWrapperData[models.ContainerRegistry](ctx, containerRegistries) {
+			loginServers[strings.ToLower(registry.Properties.LoginServer)] = registry.Id
+			registries[strings.ToLower(registry.Id)] = registry.Properties.LoginServer
+		}
+	}()
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer wg.Done()
+		for _, roleAssignments := range collectAzureWrapperData[models.AzureRoleAssignments](ctx, containerRegistryRoleAssignments) {
+			for _, roleAssignment := range roleAssignments.RoleAssignments {
+				assignments = append(assignments, models.ContainerRegistryPuller{
+					ContainerRegistryId: roleAssignments.ObjectId,
+					PrincipalId:         roleAssignment.Assignee.GetPrincipalId(),
+				})
+			}
+		}
+	}()
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer wg.Done()
+		for _, webApp := range collectAzureWrapperData[models.WebApp](ctx, webApps) {
+			if image := webApp.Properties.SiteConfig.ContainerImage(); image != "" {
+				puller := models.ContainerRegistryPuller{
+					PullerId:   webApp.Id,
+					PullerKind: enums.KindAZWebApp,
+					Image:      image,
+				}
+				if webApp.Properties.SiteConfig.AcrUseManagedIdentityCreds {
+					puller.PrincipalId = webAppAcrPrincipalId(webApp)
+				}
+				sites = append(sites, puller)
+			}
+		}
+	}()
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer wg.Done()
+		for _, cluster := range collectAzureWrapperData[models.ManagedCluster](ctx, managedClusters) {
+			if identity, ok := cluster.KubeletIdentity(); ok && identity.ObjectId != "" {
+				kubeletIdentity[strings.ToLower(identity.ObjectId)] = models.ContainerRegistryPuller{
+					PullerId:    cluster.Id,
+					PullerKind:  enums.KindAZManagedCluster,
+					PrincipalId: identity.ObjectId,
+				}
+			}
+		}
+	}()
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(out)
+		wg.Wait()
+
+		var (
+			found = make(map[string]bool)
+			count = 0
+		)
+		send := func(puller models.ContainerRegistryPuller) bool {
+			log.V(2).Info("found container registry puller", "containerRegistryId", puller.ContainerRegistryId, "pullerId", puller.PullerId)
+			count++
+			puller.TenantId = client.TenantInfo().TenantId
+			return pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+				Kind: enums.KindAZContainerRegistryPuller,
+				Data: puller,
+			})
+		}
+
+		for _, puller := range sites {
+			loginServer, _, _ := strings.Cut(puller.Image, "/")
+			if registryId, ok := loginServers[strings.ToLower(loginServer)]; ok {
+				puller.ContainerRegistryId = registryId
+				puller.LoginServer = registries[strings.ToLower(registryId)]
+				if ok := send(puller); !ok {
+					return
+				}
+			}
+		}
+
+		for _, assignment := range assignments {
+			if puller, ok := kubeletIdentity[strings.ToLower(assignment.PrincipalId)]; ok {
+				key := strings.ToLower(assignment.ContainerRegistryId + "|" + puller.PullerId)
+				if found[key] {
+					continue
+				}
+				found[key] = true
+				puller.ContainerRegistryId = assignment.ContainerRegistryId
+				puller.LoginServer = registries[strings.ToLower(assignment.ContainerRegistryId)]
+				if ok := send(puller); !ok {
+					return
+				}
+			}
+		}
+		log.Info("finished listing all container registry pullers", "count", count)
+	}()
+
+	return out
+}
+
+// webAppAcrPrincipalId resolves the managed identity a web app pulls images with: the user assigned identity named by
+// client ID in its site config, or otherwise its system assigned identity.
+func webAppAcrPrincipalId(webApp models.WebApp) string {
+	if clientId := webApp.Properties.SiteConfig.AcrUserManagedIdentityID; clientId != "" {
+		for _, identity := range webApp.Identity.UserAssignedIdentities {
+			if strings.EqualFold(identity.ClientId, clientId) {
+				return identity.PrincipalId
+			}
+		}
+		return ""
+	}
+	return webApp.Identity.PrincipalId
+}
+
+func collectAzureWrapperData[T any](ctx context.Context, in <-chan interface{}) []T {
+	var items []T
+	for result := range pipeline.OrDone(ctx.Done(), in) {
+		if item, ok := result.(AzureWrapper).Data.(T); !ok {
+			log.Error(fmt.Errorf("failed type assertion"), "unable to collect item", "result", result)
+		} else {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListContainerRegistryPullers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockContainerRegistriesChannel := make(chan interface{})
+	mockWebAppsChannel := make(chan interface{})
+	mockManagedClustersChannel := make(chan interface{})
+	mockRoleAssignmentsChannel := make(chan interface{})
+
+	registry := models.ContainerRegistry{}
+	registry.Id = "/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.ContainerRegistry/registries/registry-1"
+	registry.Properties.LoginServer = "registry-1.azurecr.io"
+
+	webApp := models.WebApp{}
+	webApp.Id = "/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.Web/sites/site-1"
+	webApp.Properties.SiteConfig.LinuxFxVersion = "DOCKER|Registry-1.azurecr.io/app:latest"
+	webApp.Properties.SiteConfig.AcrUseManagedIdentityCreds = true
+	webApp.Properties.SiteConfig.AcrUserManagedIdentityID = "uai-client-id"
+	webApp.Identity.UserAssignedIdentities = map[string]azure.UserAssignedIdentity{
+		"/subscriptions/sub-1/uai-1": {ClientId: "uai-client-id", PrincipalId: "uai-principal-id"},
+	}
+
+	unrelatedWebApp := models.WebApp{}
+	unrelatedWebApp.Id = "/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.Web/sites/site-2"
+	unrelatedWebApp.Properties.SiteConfig.LinuxFxVersion = "DOCKER|docker.io/library/nginx:latest"
+
+	cluster := models.ManagedCluster{}
+	cluster.Id = "/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.ContainerService/managedClusters/aks-1"
+	cluster.Properties.IdentityProfile = map[string]azure.ManagedClusterUserAssignedIdentity{
+		"kubeletidentity": {ObjectId: "kubelet-object-id"},
+	}
+
+	acrPull := azure.RoleAssignment{}
+	acrPull.Properties.PrincipalId = "KUBELET-OBJECT-ID"
+	reader := azure.RoleAssignment{}
+	reader.Properties.PrincipalId = "kubelet-object-id"
+
+	mockTenant := azure.Tenant{}
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	channel := listContainerRegistryPullers(ctx, mockClient, mockContainerRegistriesChannel, mockRoleAssignmentsChannel, mockWebAppsChannel, mockManagedClustersChannel)
+
+	go func() {
+		defer close(mockManagedClustersChannel)
+		mockManagedClustersChannel <- AzureWrapper{Data: cluster}
+	}()
+	go func() {
+		defer close(mockWebAppsChannel)
+		mockWebAppsChannel <- AzureWrapper{Data: webApp}
+		mockWebAppsChannel <- AzureWrapper{Data: unrelatedWebApp}
+	}()
+	go func() {
+		defer close(mockContainerRegistriesChannel)
+		mockContainerRegistriesChannel <- AzureWrapper{Data: registry}
+	}()
+	go func() {
+		defer close(mockRoleAssignmentsChannel)
+		mockRoleAssignmentsChannel <- AzureWrapper{Data: models.AzureRoleAssignments{
+			ObjectId: registry.Id,
+			RoleAssignments: []models.AzureRoleAssignment{
+				{Assignee: acrPull, ObjectId: registry.Id},
+				{Assignee: reader, ObjectId: registry.Id},
+			},
+		}}
+	}()
+
+	results := map[string]models.ContainerRegistryPuller{}
+	for result := range channel {
+		if wrapper, ok := result.(AzureWrapper); !ok {
+			t.Fatalf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+		} else if puller, ok := wrapper.Data.(models.ContainerRegistryPuller); !ok {
+			t.Fatalf("failed type assertion: got %T, want %T", wrapper.Data, models.ContainerRegistryPuller{})
+		} else {
+			results[puller.PullerId] = puller
+		}
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 pullers, got %d", len(results))
+	}
+
+	if puller := results[webApp.Id]; puller.PullerKind != enums.KindAZWebApp || puller.PrincipalId != "uai-principal-id" || puller.ContainerRegistryId != registry.Id {
+		t.Errorf("unexpected web app puller: %+v", puller)
+	}
+
+	if puller := results[cluster.Id]; puller.PullerKind != enums.KindAZManagedCluster || puller.PrincipalId != "kubelet-object-id" || puller.ContainerRegistryId != registry.Id {
+		t.Errorf("unexpected managed cluster puller: %+v", puller)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listContainerRegistryScopeMapsCmd)
+}
+
+var listContainerRegistryScopeMapsCmd = &cobra.Command{
+	Use:          "container-registry-scope-maps",
+	Long:         "Lists Azure Container Registry Scope Maps",
+	Run:          listContainerRegistryScopeMapsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listContainerRegistryScopeMapsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure container registry scope maps...")
+	start := time.Now()
+	subscriptions := listSubscriptions(ctx, azClient)
+	stream := listContainerRegistryScopeMaps(ctx, azClient, listContainerRegistries(ctx, azClient, subscriptions))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listContainerRegistryScopeMaps(ctx context.Context, client client.AzureClient, containerRegistries <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan interface{})
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		for result := range pipeline.OrDone(ctx.Done(), containerRegistries) {
+			if containerRegistry, ok := result.(AzureWrapper).Data.(models.ContainerRegistry); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating container registry scope maps", "result", result)
+				return
+			} else {
+				if ok := pipeline.SendAny(ctx.Done(), ids, containerRegistry); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for result := range stream {
+				var (
+					containerRegistry = result.(models.ContainerRegistry)
+					count             = 0
+				)
+				for item := range client.ListAzureContainerRegistryScopeMaps(ctx, containerRegistry.Id) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing scope maps for this container registry", "containerRegistryId", containerRegistry.Id)
+					} else {
+						scopeMap := models.ContainerRegistryScopeMap{
+							ContainerRegistryScopeMap: item.Ok,
+							ContainerRegistryId:       containerRegistry.Id,
+							SubscriptionId:            containerRegistry.SubscriptionId,
+							ResourceGroupId:           containerRegistry.ResourceGroupId,
+							TenantId:                  client.TenantInfo().TenantId,
+						}
+						log.V(2).Info("found container registry scope map", "name", scopeMap.Name)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZContainerRegistryScopeMap,
+							Data: scopeMap,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing container registry scope maps", "containerRegistryId", containerRegistry.Id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all container registry scope maps")
+	}()
+
+	return out
+}
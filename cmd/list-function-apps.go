@@ -103,6 +103,7 @@ func listFunctionApps(ctx context.Context, client client.AzureClient, subscripti
 							TenantId:          client.TenantInfo().TenantId,
 						}
 						if functionApp.Kind == "functionapp" {
+							functionApp.SiteSettings = listSiteSettings(ctx, client, functionApp.Id)
 							log.V(2).Info("found function app", "name", functionApp.Name)
 							count++
 							if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
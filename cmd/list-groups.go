@@ -27,6 +27,7 @@ import (
 	"github.com/bloodhoundad/azurehound/v2/client/query"
 	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
 	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
 	"github.com/bloodhoundad/azurehound/v2/pipeline"
 	"github.com/spf13/cobra"
@@ -90,3 +91,24 @@ func listGroups(ctx context.Context, client client.AzureClient) <-chan interface
 
 	return out
 }
+
+// The group delta query does not support filtering on securityEnabled, so groups reported with it unset are skipped
+// instead. Updated groups only carry the properties that changed and are kept when securityEnabled is absent.
+func listGroupsDelta(ctx context.Context, client client.AzureClient, delta *deltaState) (<-chan interface{}, <-chan interface{}) {
+	return listDelta(ctx, client, delta, enums.KindAZGroup,
+		client.ListAzureADGroupsDelta, query.GraphParams{},
+		func(item azure.DeltaItem[azure.Group]) (interface{}, bool) {
+			if item.Has("securityEnabled") && !item.Object.SecurityEnabled {
+				return nil, false
+			}
+			return AzureWrapper{
+				Kind: enums.KindAZGroup,
+				Data: models.Group{
+					Group:      item.Object,
+					TenantId:   client.TenantInfo().TenantId,
+					TenantName: client.TenantInfo().DisplayName,
+				},
+			}, true
+		},
+	)
+}
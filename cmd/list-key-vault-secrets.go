@@ -0,0 +1,132 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listKeyVaultSecretsCmd)
+}
+
+var listKeyVaultSecretsCmd = &cobra.Command{
+	Use:          "key-vault-secrets",
+	Long:         "Lists Azure Key Vault Secrets (metadata only)",
+	Run:          listKeyVaultSecretsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listKeyVaultSecretsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure key vault secrets...")
+	start := time.Now()
+	subscriptions := listSubscriptions(ctx, azClient)
+	stream := listKeyVaultSecrets(ctx, azClient, listKeyVaults(ctx, azClient, subscriptions))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listKeyVaultSecrets(ctx context.Context, client client.AzureClient, keyVaults <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		vaults  = make(chan interface{})
+		streams = pipeline.Demux(ctx.Done(), vaults, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(vaults)
+
+		for result := range pipeline.OrDone(ctx.Done(), keyVaults) {
+			if keyVault, ok := result.(AzureWrapper).Data.(models.KeyVault); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating key vault secrets", "result", result)
+				return
+			} else {
+				if ok := pipeline.SendAny(ctx.Done(), vaults, keyVault); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for result := range stream {
+				var (
+					keyVault = result.(models.KeyVault)
+					count    = 0
+				)
+				for item := range client.ListAzureKeyVaultSecrets(ctx, keyVault.Properties.VaultUri) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing secrets for this key vault", "keyVaultId", keyVault.Id)
+					} else {
+						keyVaultSecret := models.KeyVaultSecret{
+							KeyVaultSecret: item.Ok,
+							KeyVaultId:     keyVault.Id,
+							Name:           path.Base(item.Ok.Id),
+							TenantId:       client.TenantInfo().TenantId,
+						}
+						log.V(2).Info("found key vault secret", "name", keyVaultSecret.Name)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZKeyVaultSecret,
+							Data: keyVaultSecret,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing key vault secrets", "keyVaultId", keyVault.Id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all key vault secrets")
+	}()
+
+	return out
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listLighthouseDelegationsCmd)
+}
+
+var listLighthouseDelegationsCmd = &cobra.Command{
+	Use:          "lighthouse-delegations",
+	Long:         "Lists Azure Lighthouse Delegations to Managing Tenants",
+	Run:          listLighthouseDelegationsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listLighthouseDelegationsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure lighthouse delegations...")
+	start := time.Now()
+	stream := listLighthouseDelegations(ctx, azClient, listSubscriptions(ctx, azClient))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listLighthouseDelegations(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		subs    = make(chan interface{})
+		streams = pipeline.Demux(ctx.Done(), subs, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(subs)
+
+		for result := range pipeline.OrDone(ctx.Done(), subscriptions) {
+			if subscription, ok := result.(AzureWrapper).Data.(models.Subscription); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating lighthouse delegations", "result", result)
+				return
+			} else {
+				if ok := pipeline.SendAny(ctx.Done(), subs, subscription); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for result := range stream {
+				var (
+					subscription = result.(models.Subscription)
+					definitions  = make(map[string]azure.RegistrationDefinition)
+					count        = 0
+				)
+
+				// Registration definitions always live at subscription scope, even when assigned to a resource group
+				for item := range client.ListAzureRegistrationDefinitions(ctx, subscription.Id) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing registration definitions for this subscription", "subscriptionId", subscription.SubscriptionId)
+					} else {
+						definitions[strings.ToLower(item.Ok.Id)] = item.Ok
+					}
+				}
+
+				// Listing at subscription scope also returns the assignments to its resource groups
+				for item := range client.ListAzureRegistrationAssignments(ctx, subscription.Id) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing registration assignments for this subscription", "subscriptionId", subscription.SubscriptionId)
+						continue
+					}
+
+					definition, ok := definitions[strings.ToLower(item.Ok.Properties.RegistrationDefinitionId)]
+					if item.Ok.Properties.RegistrationDefinition != nil {
+						definition, ok = *item.Ok.Properties.RegistrationDefinition, true
+					}
+					if !ok {
+						log.V(1).Info("unable to resolve registration definition for this registration assignment", "registrationAssignmentId", item.Ok.Id)
+						continue
+					}
+
+					delegation := models.LighthouseDelegation{
+						RegistrationAssignmentId: item.Ok.Id,
+						RegistrationDefinitionId: item.Ok.Properties.RegistrationDefinitionId,
+						Scope:                    item.Ok.Scope(),
+						ManagedByTenantId:        definition.Properties.ManagedByTenantId,
+						ManagedByTenantName:      definition.Properties.ManagedByTenantName,
+						ManagedByTenants:         subscription.ManagedByTenants,
+						Authorizations:           definition.Properties.Authorizations,
+						EligibleAuthorizations:   definition.Properties.EligibleAuthorizations,
+						SubscriptionId:           subscription.Id,
+						TenantId:                 client.TenantInfo().TenantId,
+					}
+					log.V(2).Info("found lighthouse delegation", "scope", delegation.Scope, "managedByTenantId", delegation.ManagedByTenantId)
+					count++
+					if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+						Kind: enums.KindAZLighthouseDelegation,
+						Data: delegation,
+					}); !ok {
+						return
+					}
+				}
+				log.V(1).Info("finished listing lighthouse delegations", "subscriptionId", subscription.SubscriptionId, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all lighthouse delegations")
+	}()
+
+	return out
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListLighthouseDelegations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockSubscriptionsChannel := make(chan interface{})
+	mockDefinitionsChannel := make(chan client.AzureResult[azure.RegistrationDefinition])
+	mockSubscriptionAssignmentsChannel := make(chan client.AzureResult[azure.RegistrationAssignment])
+
+	managingTenant := uuid.Must(uuid.NewV4())
+	subscription := models.Subscription{}
+	subscription.Id = "/subscriptions/sub-1"
+	subscription.SubscriptionId = "sub-1"
+	subscription.ManagedByTenants = []azure.ManagedByTenant{{TenantId: managingTenant}}
+
+	resourceGroupId := "/subscriptions/sub-1/resourceGroups/rg-1"
+
+	subscriptionDefinition := azure.RegistrationDefinition{}
+	subscriptionDefinition.Id = "/subscriptions/sub-1/providers/Microsoft.ManagedServices/registrationDefinitions/def-1"
+	subscriptionDefinition.Properties.ManagedByTenantId = managingTenant.String()
+	subscriptionDefinition.Properties.Authorizations = []azure.LighthouseAuthorization{{PrincipalId: "principal-1", RoleDefinitionId: "role-1"}}
+
+	resourceGroupDefinition := azure.RegistrationDefinition{}
+	resourceGroupDefinition.Properties.ManagedByTenantId = managingTenant.String()
+	resourceGroupDefinition.Properties.Authorizations = []azure.LighthouseAuthorization{{PrincipalId: "principal-2", RoleDefinitionId: "role-2"}}
+
+	subscriptionAssignment := azure.RegistrationAssignment{}
+	subscriptionAssignment.Id = "/subscriptions/sub-1/providers/Microsoft.ManagedServices/registrationAssignments/assignment-1"
+	subscriptionAssignment.Properties.RegistrationDefinitionId = "/subscriptions/SUB-1/providers/Microsoft.ManagedServices/registrationDefinitions/def-1"
+
+	resourceGroupAssignment := azure.RegistrationAssignment{}
+	resourceGroupAssignment.Id = "/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.ManagedServices/registrationAssignments/assignment-2"
+	resourceGroupAssignment.Properties.RegistrationDefinition = &resourceGroupDefinition
+
+	mockTenant := azure.Tenant{}
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureRegistrationDefinitions(gomock.Any(), subscription.Id).Return(mockDefinitionsChannel).Times(1)
+	mockClient.EXPECT().ListAzureRegistrationAssignments(gomock.Any(), subscription.Id).Return(mockSubscriptionAssignmentsChannel).Times(1)
+	channel := listLighthouseDelegations(ctx, mockClient, mockSubscriptionsChannel)
+
+	go func() {
+		defer close(mockSubscriptionsChannel)
+		mockSubscriptionsChannel <- AzureWrapper{
+			Data: subscription,
+		}
+	}()
+	go func() {
+		defer close(mockDefinitionsChannel)
+		mockDefinitionsChannel <- client.AzureResult[azure.RegistrationDefinition]{
+			Ok: subscriptionDefinition,
+		}
+	}()
+	go func() {
+		defer close(mockSubscriptionAssignmentsChannel)
+		mockSubscriptionAssignmentsChannel <- client.AzureResult[azure.RegistrationAssignment]{
+			Ok: subscriptionAssignment,
+		}
+		mockSubscriptionAssignmentsChannel <- client.AzureResult[azure.RegistrationAssignment]{
+			Ok: resourceGroupAssignment,
+		}
+	}()
+
+	results := map[string]models.LighthouseDelegation{}
+	for result := range channel {
+		if wrapper, ok := result.(AzureWrapper); !ok {
+			t.Fatalf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+		} else if delegation, ok := wrapper.Data.(models.LighthouseDelegation); !ok {
+			t.Fatalf("failed type assertion: got %T, want %T", wrapper.Data, models.LighthouseDelegation{})
+		} else {
+			results[delegation.Scope] = delegation
+		}
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 delegations, got %d", len(results))
+	}
+
+	if delegation, ok := results[subscription.Id]; !ok {
+		t.Errorf("expected a delegation at subscription scope")
+	} else if len(delegation.Authorizations) != 1 || delegation.Authorizations[0].PrincipalId != "principal-1" {
+		t.Errorf("expected the subscription delegation to resolve its definition by id, got %+v", delegation.Authorizations)
+	} else if len(delegation.ManagedByTenants) != 1 || delegation.ManagedByTenants[0].TenantId != managingTenant {
+		t.Errorf("expected the subscription's managing tenants to be carried, got %+v", delegation.ManagedByTenants)
+	}
+
+	if delegation, ok := results[resourceGroupId]; !ok {
+		t.Errorf("expected a delegation at resource group scope")
+	} else if len(delegation.Authorizations) != 1 || delegation.Authorizations[0].PrincipalId != "principal-2" {
+		t.Errorf("expected the resource group delegation to use its expanded definition, got %+v", delegation.Authorizations)
+	}
+}
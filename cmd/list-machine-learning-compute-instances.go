@@ -0,0 +1,133 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listMachineLearningComputeInstancesCmd)
+}
+
+var listMachineLearningComputeInstancesCmd = &cobra.Command{
+	Use:          "machine-learning-compute-instances",
+	Long:         "Lists Azure Machine Learning Compute Instances",
+	Run:          listMachineLearningComputeInstancesCmdImpl,
+	SilenceUsage: true,
+}
+
+func listMachineLearningComputeInstancesCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure machine learning compute instances...")
+	start := time.Now()
+	subscriptions := listSubscriptions(ctx, azClient)
+	stream := listMachineLearningComputeInstances(ctx, azClient, listMachineLearningWorkspaces(ctx, azClient, subscriptions))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listMachineLearningComputeInstances(ctx context.Context, client client.AzureClient, machineLearningWorkspaces <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan interface{})
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		for result := range pipeline.OrDone(ctx.Done(), machineLearningWorkspaces) {
+			if workspace, ok := result.(AzureWrapper).Data.(models.MachineLearningWorkspace); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating machine learning compute instances", "result", result)
+				return
+			} else {
+				if ok := pipeline.SendAny(ctx.Done(), ids, workspace); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for result := range stream {
+				var (
+					workspace = result.(models.MachineLearningWorkspace)
+					count     = 0
+				)
+				for item := range client.ListAzureMachineLearningComputes(ctx, workspace.Id) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing computes for this machine learning workspace", "workspaceId", workspace.Id)
+					} else if item.Ok.Properties.ComputeType == azure.MachineLearningComputeInstanceType {
+						computeInstance := models.MachineLearningComputeInstance{
+							MachineLearningCompute: item.Ok,
+							WorkspaceId:            workspace.Id,
+							SubscriptionId:         workspace.SubscriptionId,
+							ResourceGroupId:        workspace.ResourceGroupId,
+							TenantId:               client.TenantInfo().TenantId,
+						}
+						log.V(2).Info("found machine learning compute instance", "name", computeInstance.Name)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZMLComputeInstance,
+							Data: computeInstance,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing machine learning compute instances", "workspaceId", workspace.Id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all machine learning compute instances")
+	}()
+
+	return out
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListMachineLearningComputeInstances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockWorkspacesChannel := make(chan interface{})
+	mockComputeChannel := make(chan client.AzureResult[azure.MachineLearningCompute])
+
+	mockTenant := azure.Tenant{}
+	mockError := fmt.Errorf("I'm an error")
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureMachineLearningComputes(gomock.Any(), "workspace").Return(mockComputeChannel).Times(1)
+	channel := listMachineLearningComputeInstances(ctx, mockClient, mockWorkspacesChannel)
+
+	go func() {
+		defer close(mockWorkspacesChannel)
+		mockWorkspacesChannel <- AzureWrapper{
+			Data: models.MachineLearningWorkspace{
+				MachineLearningWorkspace: azure.MachineLearningWorkspace{
+					Entity: azure.Entity{Id: "workspace"},
+				},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockComputeChannel)
+		instance := azure.MachineLearningCompute{}
+		instance.Properties.ComputeType = azure.MachineLearningComputeInstanceType
+		instance.Properties.Properties.PersonalComputeInstanceSettings.AssignedUser.ObjectId = "user"
+		cluster := azure.MachineLearningCompute{}
+		cluster.Properties.ComputeType = "AmlCompute"
+
+		mockComputeChannel <- client.AzureResult[azure.MachineLearningCompute]{Ok: cluster}
+		mockComputeChannel <- client.AzureResult[azure.MachineLearningCompute]{Ok: instance}
+		mockComputeChannel <- client.AzureResult[azure.MachineLearningCompute]{Error: mockError}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if wrapper.Kind != enums.KindAZMLComputeInstance {
+		t.Errorf("unexpected kind: got %s, want %s", wrapper.Kind, enums.KindAZMLComputeInstance)
+	} else if data, ok := wrapper.Data.(models.MachineLearningComputeInstance); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.MachineLearningComputeInstance{})
+	} else if data.WorkspaceId != "workspace" {
+		t.Errorf("unexpected workspace id: got %s, want %s", data.WorkspaceId, "workspace")
+	} else if assignedUser := data.Properties.Properties.PersonalComputeInstanceSettings.AssignedUser.ObjectId; assignedUser != "user" {
+		t.Errorf("unexpected assigned user: got %s, want %s", assignedUser, "user")
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
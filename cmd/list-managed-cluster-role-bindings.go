@@ -0,0 +1,164 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listManagedClusterRoleBindingsCmd)
+}
+
+var listManagedClusterRoleBindingsCmd = &cobra.Command{
+	Use:          "managed-cluster-role-bindings",
+	Long:         "Lists Kubernetes ClusterRoleBindings and RoleBindings for Entra users and groups in Azure Kubernetes Service Managed Clusters",
+	Run:          listManagedClusterRoleBindingsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listManagedClusterRoleBindingsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure managed cluster role bindings...")
+	start := time.Now()
+	subscriptions := listSubscriptions(ctx, azClient)
+	stream := listManagedClusterRoleBindings(ctx, azClient, listManagedClusters(ctx, azClient, subscriptions))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listManagedClusterRoleBindings(ctx context.Context, azClient client.AzureClient, managedClusters <-chan interface{}) <-chan interface{} {
+	var (
+		out      = make(chan interface{})
+		clusters = make(chan interface{})
+		streams  = pipeline.Demux(ctx.Done(), clusters, config.ColStreamCount.Value().(int))
+		wg       sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(clusters)
+
+		for result := range pipeline.OrDone(ctx.Done(), managedClusters) {
+			if managedCluster, ok := result.(AzureWrapper).Data.(models.ManagedCluster); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating managed cluster role bindings", "result", result)
+				return
+			} else if ok := pipeline.SendAny(ctx.Done(), clusters, managedCluster); !ok {
+				return
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for result := range stream {
+				var (
+					managedCluster = result.(models.ManagedCluster)
+					credentials    azure.ManagedClusterCredentials
+					err            error
+				)
+
+				// Clusters with local accounts disabled have no admin credentials; their user credentials are
+				// authenticated with an Entra token for the AKS API server instead
+				if managedCluster.Properties.DisableLocalAccounts {
+					credentials, err = azClient.GetAzureManagedClusterUserCredentials(ctx, managedCluster.Id)
+				} else {
+					credentials, err = azClient.GetAzureManagedClusterAdminCredentials(ctx, managedCluster.Id)
+				}
+
+				if err != nil {
+					log.Error(err, "unable to fetch credentials for this managed cluster", "managedClusterId", managedCluster.Id)
+					continue
+				} else if len(credentials.Kubeconfigs) == 0 {
+					log.V(1).Info("no credentials returned for this managed cluster", "managedClusterId", managedCluster.Id)
+					continue
+				}
+
+				var (
+					kubeconfig = credentials.Kubeconfigs[0].Value
+					count      = 0
+				)
+				for _, kind := range []enums.Kind{enums.KindAZKubernetesClusterRoleBinding, enums.KindAZKubernetesRoleBinding} {
+					var items <-chan client.AzureResult[azure.KubernetesRoleBinding]
+					if kind == enums.KindAZKubernetesRoleBinding {
+						items = azClient.ListKubernetesRoleBindings(ctx, kubeconfig)
+					} else {
+						items = azClient.ListKubernetesClusterRoleBindings(ctx, kubeconfig)
+					}
+					for item := range items {
+						if item.Error != nil {
+							log.Error(item.Error, "unable to continue processing role bindings for this managed cluster", "managedClusterId", managedCluster.Id)
+						} else if subjects := item.Ok.EntraSubjects(); len(subjects) == 0 {
+							continue
+						} else {
+							roleBinding := models.KubernetesRoleBinding{
+								KubernetesRoleBinding: item.Ok,
+								ManagedClusterId:      managedCluster.Id,
+								SubscriptionId:        managedCluster.SubscriptionId,
+								ResourceGroupId:       managedCluster.ResourceGroupId,
+								TenantId:              azClient.TenantInfo().TenantId,
+							}
+							roleBinding.Subjects = subjects
+							log.V(2).Info("found managed cluster role binding", "name", roleBinding.Metadata.Name, "namespace", roleBinding.Metadata.Namespace)
+							count++
+							if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+								Kind: kind,
+								Data: roleBinding,
+							}); !ok {
+								return
+							}
+						}
+					}
+				}
+				log.V(1).Info("finished listing managed cluster role bindings", "managedClusterId", managedCluster.Id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all managed cluster role bindings")
+	}()
+
+	return out
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListManagedClusterRoleBindings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockManagedClustersChannel := make(chan interface{})
+	mockClusterRoleBindingsChannel := make(chan client.AzureResult[azure.KubernetesRoleBinding])
+	mockRoleBindingsChannel := make(chan client.AzureResult[azure.KubernetesRoleBinding])
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().GetAzureManagedClusterAdminCredentials(gomock.Any(), gomock.Any()).Return(azure.ManagedClusterCredentials{
+		Kubeconfigs: []azure.ManagedClusterCredential{{Name: "clusterAdmin", Value: []byte("kubeconfig")}},
+	}, nil).Times(1)
+	mockClient.EXPECT().ListKubernetesClusterRoleBindings(gomock.Any(), gomock.Any()).Return(mockClusterRoleBindingsChannel).Times(1)
+	mockClient.EXPECT().ListKubernetesRoleBindings(gomock.Any(), gomock.Any()).Return(mockRoleBindingsChannel).Times(1)
+	channel := listManagedClusterRoleBindings(ctx, mockClient, mockManagedClustersChannel)
+
+	go func() {
+		defer close(mockManagedClustersChannel)
+		mockManagedClustersChannel <- AzureWrapper{
+			Data: models.ManagedCluster{},
+		}
+	}()
+	go func() {
+		defer close(mockClusterRoleBindingsChannel)
+		mockClusterRoleBindingsChannel <- client.AzureResult[azure.KubernetesRoleBinding]{
+			Ok: azure.KubernetesRoleBinding{
+				Subjects: []azure.KubernetesSubject{{Kind: "User", Name: "user@contoso.com"}},
+			},
+		}
+		mockClusterRoleBindingsChannel <- client.AzureResult[azure.KubernetesRoleBinding]{
+			Ok: azure.KubernetesRoleBinding{
+				Subjects: []azure.KubernetesSubject{{Kind: "Group", Name: "system:masters"}},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockRoleBindingsChannel)
+		mockRoleBindingsChannel <- client.AzureResult[azure.KubernetesRoleBinding]{
+			Ok: azure.KubernetesRoleBinding{
+				Subjects: []azure.KubernetesSubject{{Kind: "Group", Name: "00000000-0000-0000-0000-000000000000"}},
+			},
+		}
+	}()
+
+	var kinds []enums.Kind
+	for result := range channel {
+		if wrapper, ok := result.(AzureWrapper); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+		} else if _, ok := wrapper.Data.(models.KubernetesRoleBinding); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.KubernetesRoleBinding{})
+		} else {
+			kinds = append(kinds, wrapper.Kind)
+		}
+	}
+
+	if len(kinds) != 2 || kinds[0] != enums.KindAZKubernetesClusterRoleBinding || kinds[1] != enums.KindAZKubernetesRoleBinding {
+		t.Errorf("got %v, want a cluster role binding followed by a role binding", kinds)
+	}
+}
+
+func TestListManagedClusterRoleBindingsWithLocalAccountsDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockManagedClustersChannel := make(chan interface{})
+	mockClusterRoleBindingsChannel := make(chan client.AzureResult[azure.KubernetesRoleBinding])
+	mockRoleBindingsChannel := make(chan client.AzureResult[azure.KubernetesRoleBinding])
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().GetAzureManagedClusterUserCredentials(gomock.Any(), "cluster").Return(azure.ManagedClusterCredentials{
+		Kubeconfigs: []azure.ManagedClusterCredential{{Name: "clusterUser", Value: []byte("kubeconfig")}},
+	}, nil).Times(1)
+	mockClient.EXPECT().ListKubernetesClusterRoleBindings(gomock.Any(), []byte("kubeconfig")).Return(mockClusterRoleBindingsChannel).Times(1)
+	mockClient.EXPECT().ListKubernetesRoleBindings(gomock.Any(), []byte("kubeconfig")).Return(mockRoleBindingsChannel).Times(1)
+	channel := listManagedClusterRoleBindings(ctx, mockClient, mockManagedClustersChannel)
+
+	go func() {
+		defer close(mockManagedClustersChannel)
+		managedCluster := models.ManagedCluster{}
+		managedCluster.Id = "cluster"
+		managedCluster.Properties.DisableLocalAccounts = true
+		mockManagedClustersChannel <- AzureWrapper{
+			Data: managedCluster,
+		}
+	}()
+	go func() {
+		defer close(mockClusterRoleBindingsChannel)
+		mockClusterRoleBindingsChannel <- client.AzureResult[azure.KubernetesRoleBinding]{
+			Ok: azure.KubernetesRoleBinding{
+				Subjects: []azure.KubernetesSubject{{Kind: "Group", Name: "00000000-0000-0000-0000-000000000001"}},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockRoleBindingsChannel)
+	}()
+
+	var count int
+	for result := range channel {
+		if wrapper, ok := result.(AzureWrapper); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+		} else if roleBinding, ok := wrapper.Data.(models.KubernetesRoleBinding); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.KubernetesRoleBinding{})
+		} else if roleBinding.ManagedClusterId != "cluster" {
+			t.Errorf("got managed cluster id %q, want %q", roleBinding.ManagedClusterId, "cluster")
+		} else {
+			count++
+		}
+	}
+
+	if count != 1 {
+		t.Errorf("got %d role bindings, want 1", count)
+	}
+}
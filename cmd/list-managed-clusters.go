@@ -101,10 +101,11 @@ func listManagedClusters(ctx context.Context, client client.AzureClient, subscri
 						log.Error(item.Error, "unable to continue processing managed clusters for this subscription", "subscriptionId", id)
 					} else {
 						managedCluster := models.ManagedCluster{
-							ManagedCluster:  item.Ok,
-							SubscriptionId:  "/subscriptions/" + id,
-							ResourceGroupId: item.Ok.ResourceGroupId(),
-							TenantId:        client.TenantInfo().TenantId,
+							ManagedCluster:   item.Ok,
+							SubscriptionId:   "/subscriptions/" + id,
+							ResourceGroupId:  item.Ok.ResourceGroupId(),
+							TenantId:         client.TenantInfo().TenantId,
+							AzureRBACEnabled: item.Ok.AzureRBACEnabled(),
 						}
 						log.V(2).Info("found managed cluster", "name", managedCluster.Name)
 						count++
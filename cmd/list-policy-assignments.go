@@ -0,0 +1,232 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listPolicyAssignmentsCmd)
+}
+
+var listPolicyAssignmentsCmd = &cobra.Command{
+	Use:          "policy-assignments",
+	Long:         "Lists Azure Policy Assignments at management group and subscription scope",
+	Run:          listPolicyAssignmentsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listPolicyAssignmentsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure policy assignments...")
+	start := time.Now()
+	scopes := pipeline.Mux(ctx.Done(), listManagementGroups(ctx, azClient), listSubscriptions(ctx, azClient))
+	stream := listPolicyAssignments(ctx, azClient, scopes)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listPolicyAssignments(ctx context.Context, client client.AzureClient, scopes <-chan interface{}) <-chan interface{} {
+	var (
+		out      = make(chan interface{})
+		ids      = make(chan string)
+		streams  = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg       sync.WaitGroup
+		resolver = newPolicyEffectResolver(client)
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		for result := range pipeline.OrDone(ctx.Done(), scopes) {
+			if id, ok := policyScopeId(result); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating policy assignments", "result", result)
+				return
+			} else {
+				if ok := pipeline.Send(ctx.Done(), ids, id); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				count := 0
+				for item := range client.ListAzurePolicyAssignments(ctx, id) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing policy assignments for this scope", "scope", id)
+					} else {
+						effects, roleDefinitionIds := resolver.resolve(ctx, item.Ok)
+						policyAssignment := models.PolicyAssignment{
+							PolicyAssignment:  item.Ok,
+							Effects:           effects,
+							RoleDefinitionIds: roleDefinitionIds,
+							TenantId:          client.TenantInfo().TenantId,
+						}
+						log.V(2).Info("found policy assignment", "name", policyAssignment.Name, "effects", policyAssignment.Effects)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZPolicyAssignment,
+							Data: policyAssignment,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing policy assignments", "scope", id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all policy assignments")
+	}()
+
+	return out
+}
+
+// policyEffectResolver resolves the effects of policy assignments, fetching each policy and policy set definition
+// they reference once since the same built-in definitions are typically assigned at many scopes.
+type policyEffectResolver struct {
+	client         client.AzureClient
+	mutex          sync.Mutex
+	definitions    map[string]*azure.PolicyDefinition
+	setDefinitions map[string]*azure.PolicySetDefinition
+}
+
+func newPolicyEffectResolver(client client.AzureClient) *policyEffectResolver {
+	return &policyEffectResolver{
+		client:         client,
+		definitions:    map[string]*azure.PolicyDefinition{},
+		setDefinitions: map[string]*azure.PolicySetDefinition{},
+	}
+}
+
+// resolve returns the sorted, distinct effects of the policies applied by the assignment and the roles required to
+// remediate those that deploy or modify resources. Definitions that cannot be fetched are skipped.
+func (s *policyEffectResolver) resolve(ctx context.Context, assignment azure.PolicyAssignment) ([]string, []string) {
+	var (
+		effects           = map[string]bool{}
+		roleDefinitionIds = map[string]bool{}
+	)
+
+	add := func(definition *azure.PolicyDefinition, parameters map[string]azure.PolicyParameterValue) {
+		effect := definition.Effect(parameters)
+		effects[effect] = true
+		if effect == azure.PolicyEffectDeployIfNotExists || effect == azure.PolicyEffectModify {
+			for _, roleDefinitionId := range definition.Properties.PolicyRule.Then.Details.RoleDefinitionIds {
+				roleDefinitionIds[strings.ToLower(roleDefinitionId)] = true
+			}
+		}
+	}
+
+	if !assignment.IsPolicySet() {
+		if definition := s.definition(ctx, assignment.Properties.PolicyDefinitionId); definition != nil {
+			add(definition, assignment.Properties.Parameters)
+		}
+	} else if setDefinition := s.setDefinition(ctx, assignment.Properties.PolicyDefinitionId); setDefinition != nil {
+		for _, member := range setDefinition.Properties.PolicyDefinitions {
+			if definition := s.definition(ctx, member.PolicyDefinitionId); definition != nil {
+				add(definition, setDefinition.MemberParameters(member, assignment.Properties.Parameters))
+			}
+		}
+	}
+
+	return sortedKeys(effects), sortedKeys(roleDefinitionIds)
+}
+
+func (s *policyEffectResolver) definition(ctx context.Context, id string) *azure.PolicyDefinition {
+	key := strings.ToLower(id)
+
+	s.mutex.Lock()
+	definition, ok := s.definitions[key]
+	s.mutex.Unlock()
+
+	if !ok {
+		if result, err := s.client.GetAzurePolicyDefinition(ctx, id); err != nil {
+			log.Error(err, "unable to resolve the effect of this policy definition", "policyDefinitionId", id)
+		} else {
+			definition = &result
+		}
+		s.mutex.Lock()
+		s.definitions[key] = definition
+		s.mutex.Unlock()
+	}
+	return definition
+}
+
+func (s *policyEffectResolver) setDefinition(ctx context.Context, id string) *azure.PolicySetDefinition {
+	key := strings.ToLower(id)
+
+	s.mutex.Lock()
+	setDefinition, ok := s.setDefinitions[key]
+	s.mutex.Unlock()
+
+	if !ok {
+		if result, err := s.client.GetAzurePolicySetDefinition(ctx, id); err != nil {
+			log.Error(err, "unable to resolve the policies of this policy set definition", "policySetDefinitionId", id)
+		} else {
+			setDefinition = &result
+		}
+		s.mutex.Lock()
+		s.setDefinitions[key] = setDefinition
+		s.mutex.Unlock()
+	}
+	return setDefinition
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListPolicyAssignments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	const (
+		setDefinitionId = "/providers/Microsoft.Authorization/policySetDefinitions/set"
+		deployId        = "/providers/Microsoft.Authorization/policyDefinitions/deploy"
+		auditId         = "/providers/Microsoft.Authorization/policyDefinitions/audit"
+		contributorId   = "/providers/Microsoft.Authorization/roleDefinitions/b24988ac-6180-42a0-ab88-20f7382dd24c"
+	)
+
+	deploy := azure.PolicyDefinition{}
+	deploy.Properties.Parameters = map[string]azure.PolicyParameterDefinition{"effect": {DefaultValue: "AuditIfNotExists"}}
+	deploy.Properties.PolicyRule.Then.Effect = "[parameters('effect')]"
+	deploy.Properties.PolicyRule.Then.Details.RoleDefinitionIds = []string{contributorId}
+
+	audit := azure.PolicyDefinition{}
+	audit.Properties.PolicyRule.Then.Effect = "Audit"
+
+	setDefinition := azure.PolicySetDefinition{}
+	setDefinition.Properties.Parameters = map[string]azure.PolicyParameterDefinition{"deployEffect": {DefaultValue: "AuditIfNotExists"}}
+	setDefinition.Properties.PolicyDefinitions = []azure.PolicyDefinitionReference{
+		{PolicyDefinitionId: deployId, Parameters: map[string]azure.PolicyParameterValue{"effect": {Value: "[parameters('deployEffect')]"}}},
+		{PolicyDefinitionId: auditId},
+	}
+
+	mockScopesChannel := make(chan interface{})
+	mockAssignmentsChannel := make(chan client.AzureResult[azure.PolicyAssignment])
+	mockAssignmentsChannel2 := make(chan client.AzureResult[azure.PolicyAssignment])
+
+	mockTenant := azure.Tenant{}
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzurePolicyAssignments(gomock.Any(), "/providers/Microsoft.Management/managementGroups/mg").Return(mockAssignmentsChannel).Times(1)
+	mockClient.EXPECT().ListAzurePolicyAssignments(gomock.Any(), "/subscriptions/sub").Return(mockAssignmentsChannel2).Times(1)
+	mockClient.EXPECT().GetAzurePolicySetDefinition(gomock.Any(), setDefinitionId).Return(setDefinition, nil).MinTimes(1)
+	mockClient.EXPECT().GetAzurePolicyDefinition(gomock.Any(), deployId).Return(deploy, nil).MinTimes(1)
+	mockClient.EXPECT().GetAzurePolicyDefinition(gomock.Any(), auditId).Return(audit, nil).MinTimes(1)
+	channel := listPolicyAssignments(ctx, mockClient, mockScopesChannel)
+
+	go func() {
+		defer close(mockScopesChannel)
+		mockScopesChannel <- AzureWrapper{
+			Data: models.ManagementGroup{
+				ManagementGroup: azure.ManagementGroup{Entity: azure.Entity{Id: "/providers/Microsoft.Management/managementGroups/mg"}},
+			},
+		}
+		mockScopesChannel <- AzureWrapper{
+			Data: models.Subscription{
+				Subscription: azure.Subscription{Entity: azure.Entity{Id: "/subscriptions/sub"}},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockAssignmentsChannel)
+		assignment := azure.PolicyAssignment{Name: "remediating"}
+		assignment.Properties.PolicyDefinitionId = setDefinitionId
+		assignment.Properties.Parameters = map[string]azure.PolicyParameterValue{"deployEffect": {Value: "DeployIfNotExists"}}
+		mockAssignmentsChannel <- client.AzureResult[azure.PolicyAssignment]{Ok: assignment}
+	}()
+	go func() {
+		defer close(mockAssignmentsChannel2)
+		assignment := azure.PolicyAssignment{Name: "auditing"}
+		assignment.Properties.PolicyDefinitionId = auditId
+		mockAssignmentsChannel2 <- client.AzureResult[azure.PolicyAssignment]{Ok: assignment}
+	}()
+
+	want := map[string]models.PolicyAssignment{
+		"remediating": {Effects: []string{"audit", "deployifnotexists"}, RoleDefinitionIds: []string{strings.ToLower(contributorId)}},
+		"auditing":    {Effects: []string{"audit"}, RoleDefinitionIds: []string{}},
+	}
+	for i := 0; i < len(want); i++ {
+		if result, ok := <-channel; !ok {
+			t.Fatalf("failed to receive from channel")
+		} else if wrapper, ok := result.(AzureWrapper); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+		} else if wrapper.Kind != enums.KindAZPolicyAssignment {
+			t.Errorf("unexpected kind: got %s, want %s", wrapper.Kind, enums.KindAZPolicyAssignment)
+		} else if data, ok := wrapper.Data.(models.PolicyAssignment); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.PolicyAssignment{})
+		} else if expected := want[data.Name]; !reflect.DeepEqual(data.Effects, expected.Effects) {
+			t.Errorf("unexpected effects for %s: got %v, want %v", data.Name, data.Effects, expected.Effects)
+		} else if !reflect.DeepEqual(data.RoleDefinitionIds, expected.RoleDefinitionIds) {
+			t.Errorf("unexpected role definition ids for %s: got %v, want %v", data.Name, data.RoleDefinitionIds, expected.RoleDefinitionIds)
+		}
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
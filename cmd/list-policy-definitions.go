@@ -0,0 +1,140 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listPolicyDefinitionsCmd)
+}
+
+var listPolicyDefinitionsCmd = &cobra.Command{
+	Use:          "policy-definitions",
+	Long:         "Lists custom Azure Policy Definitions stored at management group and subscription scope",
+	Run:          listPolicyDefinitionsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listPolicyDefinitionsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure policy definitions...")
+	start := time.Now()
+	scopes := pipeline.Mux(ctx.Done(), listManagementGroups(ctx, azClient), listSubscriptions(ctx, azClient))
+	stream := listPolicyDefinitions(ctx, azClient, scopes)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// policyScopeId returns the ID of a management group or subscription that policies can be stored at and assigned to.
+func policyScopeId(result interface{}) (string, bool) {
+	switch scope := result.(AzureWrapper).Data.(type) {
+	case models.ManagementGroup:
+		return scope.Id, true
+	case models.Subscription:
+		return scope.Id, true
+	default:
+		return "", false
+	}
+}
+
+func listPolicyDefinitions(ctx context.Context, client client.AzureClient, scopes <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan string)
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		for result := range pipeline.OrDone(ctx.Done(), scopes) {
+			if id, ok := policyScopeId(result); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating policy definitions", "result", result)
+				return
+			} else {
+				if ok := pipeline.Send(ctx.Done(), ids, id); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				count := 0
+				for item := range client.ListAzurePolicyDefinitions(ctx, id) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing policy definitions for this scope", "scope", id)
+					} else {
+						policyDefinition := models.PolicyDefinition{
+							PolicyDefinition: item.Ok,
+							Effect:           item.Ok.Effect(nil),
+							Scope:            id,
+							TenantId:         client.TenantInfo().TenantId,
+						}
+						log.V(2).Info("found policy definition", "name", policyDefinition.Name, "effect", policyDefinition.Effect)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZPolicyDefinition,
+							Data: policyDefinition,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing policy definitions", "scope", id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all policy definitions")
+	}()
+
+	return out
+}
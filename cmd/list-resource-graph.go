@@ -0,0 +1,340 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+)
+
+type subscriptionResourceLister func(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{}
+
+// subscriptionResourceListers list the resources of each subscription by type for listAllRM, either with a request per
+// subscription and type or with a single Azure Resource Graph query per type across all subscriptions.
+type subscriptionResourceListers struct {
+	automationAccounts        subscriptionResourceLister
+	cognitiveServicesAccounts subscriptionResourceLister
+	containerRegistries       subscriptionResourceLister
+	functionApps              subscriptionResourceLister
+	keyVaults                 subscriptionResourceLister
+	logicApps                 subscriptionResourceLister
+	machineLearningWorkspaces subscriptionResourceLister
+	managedClusters           subscriptionResourceLister
+	resourceGroups            subscriptionResourceLister
+	virtualMachines           subscriptionResourceLister
+	vmScaleSets               subscriptionResourceLister
+	webApps                   subscriptionResourceLister
+}
+
+func newSubscriptionResourceListers(useResourceGraph bool) subscriptionResourceListers {
+	if !useResourceGraph {
+		return subscriptionResourceListers{
+			automationAccounts:        listAutomationAccounts,
+			cognitiveServicesAccounts: listCognitiveServicesAccounts,
+			containerRegistries:       listContainerRegistries,
+			functionApps:              listFunctionApps,
+			keyVaults:                 listKeyVaults,
+			logicApps:                 listLogicApps,
+			machineLearningWorkspaces: listMachineLearningWorkspaces,
+			managedClusters:           listManagedClusters,
+			resourceGroups:            listResourceGroups,
+			virtualMachines:           listVirtualMachines,
+			vmScaleSets:               listVMScaleSets,
+			webApps:                   listWebApps,
+		}
+	}
+
+	return subscriptionResourceListers{
+		automationAccounts: func(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+			return listResourceGraph(ctx, client, subscriptions, "automation accounts", "resources | where type =~ 'microsoft.automation/automationaccounts'",
+				func(subscriptionId string, item azure.AutomationAccount) (AzureWrapper, bool) {
+					return AzureWrapper{
+						Kind: enums.KindAZAutomationAccount,
+						Data: models.AutomationAccount{
+							AutomationAccount: item,
+							SubscriptionId:    "/subscriptions/" + subscriptionId,
+							ResourceGroupId:   item.ResourceGroupId(),
+							TenantId:          client.TenantInfo().TenantId,
+						},
+					}, true
+				})
+		},
+		cognitiveServicesAccounts: func(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+			return listResourceGraph(ctx, client, subscriptions, "cognitive services accounts", "resources | where type =~ 'microsoft.cognitiveservices/accounts'",
+				func(subscriptionId string, item azure.CognitiveServicesAccount) (AzureWrapper, bool) {
+					return AzureWrapper{
+						Kind: enums.KindAZCognitiveServicesAccount,
+						Data: models.CognitiveServicesAccount{
+							CognitiveServicesAccount: item,
+							SubscriptionId:           "/subscriptions/" + subscriptionId,
+							ResourceGroupId:          item.ResourceGroupId(),
+							ResourceGroupName:        item.ResourceGroupName(),
+							TenantId:                 client.TenantInfo().TenantId,
+						},
+					}, true
+				})
+		},
+		containerRegistries: func(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+			return listResourceGraph(ctx, client, subscriptions, "container registries", "resources | where type =~ 'microsoft.containerregistry/registries'",
+				func(subscriptionId string, item azure.ContainerRegistry) (AzureWrapper, bool) {
+					return AzureWrapper{
+						Kind: enums.KindAZContainerRegistry,
+						Data: models.ContainerRegistry{
+							ContainerRegistry: item,
+							SubscriptionId:    "/subscriptions/" + subscriptionId,
+							ResourceGroupId:   item.ResourceGroupId(),
+							TenantId:          client.TenantInfo().TenantId,
+						},
+					}, true
+				})
+		},
+		functionApps: func(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+			return listResourceGraph(ctx, client, subscriptions, "function apps", "resources | where type =~ 'microsoft.web/sites' and kind =~ 'functionapp'",
+				func(subscriptionId string, item azure.FunctionApp) (AzureWrapper, bool) {
+					if item.Kind != "functionapp" {
+						return AzureWrapper{}, false
+					}
+					return AzureWrapper{
+						Kind: enums.KindAZFunctionApp,
+						Data: models.FunctionApp{
+							FunctionApp:       item,
+							SubscriptionId:    "/subscriptions/" + subscriptionId,
+							ResourceGroupId:   item.ResourceGroupId(),
+							ResourceGroupName: item.ResourceGroupName(),
+							TenantId:          client.TenantInfo().TenantId,
+							SiteSettings:      listSiteSettings(ctx, client, item.Id),
+						},
+					}, true
+				})
+		},
+		keyVaults: func(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+			return listResourceGraph(ctx, client, subscriptions, "key vaults", "resources | where type =~ 'microsoft.keyvault/vaults'",
+				func(subscriptionId string, item azure.KeyVault) (AzureWrapper, bool) {
+					return AzureWrapper{
+						Kind: enums.KindAZKeyVault,
+						Data: models.KeyVault{
+							KeyVault:       item,
+							SubscriptionId: subscriptionId,
+							ResourceGroup:  item.ResourceGroupId(),
+							TenantId:       item.Properties.TenantId,
+						},
+					}, true
+				})
+		},
+		logicApps: func(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+			return listResourceGraph(ctx, client, subscriptions, "logic apps", "resources | where type =~ 'microsoft.logic/workflows'",
+				func(subscriptionId string, item azure.LogicApp) (AzureWrapper, bool) {
+					return AzureWrapper{
+						Kind: enums.KindAZLogicApp,
+						Data: models.LogicApp{
+							LogicApp:        item,
+							SubscriptionId:  "/subscriptions/" + subscriptionId,
+							ResourceGroupId: item.ResourceGroupId(),
+							TenantId:        client.TenantInfo().TenantId,
+						},
+					}, true
+				})
+		},
+		machineLearningWorkspaces: func(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+			return listResourceGraph(ctx, client, subscriptions, "machine learning workspaces", "resources | where type =~ 'microsoft.machinelearningservices/workspaces'",
+				func(subscriptionId string, item azure.MachineLearningWorkspace) (AzureWrapper, bool) {
+					return AzureWrapper{
+						Kind: enums.KindAZMLWorkspace,
+						Data: models.MachineLearningWorkspace{
+							MachineLearningWorkspace: item,
+							SubscriptionId:           "/subscriptions/" + subscriptionId,
+							ResourceGroupId:          item.ResourceGroupId(),
+							ResourceGroupName:        item.ResourceGroupName(),
+							TenantId:                 client.TenantInfo().TenantId,
+						},
+					}, true
+				})
+		},
+		managedClusters: func(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+			return listResourceGraph(ctx, client, subscriptions, "managed clusters", "resources | where type =~ 'microsoft.containerservice/managedclusters'",
+				func(subscriptionId string, item azure.ManagedCluster) (AzureWrapper, bool) {
+					return AzureWrapper{
+						Kind: enums.KindAZManagedCluster,
+						Data: models.ManagedCluster{
+							ManagedCluster:   item,
+							SubscriptionId:   "/subscriptions/" + subscriptionId,
+							ResourceGroupId:  item.ResourceGroupId(),
+							TenantId:         client.TenantInfo().TenantId,
+							AzureRBACEnabled: item.AzureRBACEnabled(),
+						},
+					}, true
+				})
+		},
+		resourceGroups: func(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+			return listResourceGraph(ctx, client, subscriptions, "resource groups", "resourcecontainers | where type =~ 'microsoft.resources/subscriptions/resourcegroups'",
+				func(subscriptionId string, item azure.ResourceGroup) (AzureWrapper, bool) {
+					return AzureWrapper{
+						Kind: enums.KindAZResourceGroup,
+						Data: models.ResourceGroup{
+							ResourceGroup:  item,
+							SubscriptionId: "/subscriptions/" + subscriptionId,
+							TenantId:       client.TenantInfo().TenantId,
+						},
+					}, true
+				})
+		},
+		virtualMachines: func(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+			return listResourceGraph(ctx, client, subscriptions, "virtual machines", "resources | where type =~ 'microsoft.compute/virtualmachines'",
+				func(subscriptionId string, item azure.VirtualMachine) (AzureWrapper, bool) {
+					return AzureWrapper{
+						Kind: enums.KindAZVM,
+						Data: models.VirtualMachine{
+							VirtualMachine:  item,
+							SubscriptionId:  "/subscriptions/" + subscriptionId,
+							ResourceGroupId: item.ResourceGroupId(),
+							TenantId:        client.TenantInfo().TenantId,
+						},
+					}, true
+				})
+		},
+		vmScaleSets: func(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+			return listResourceGraph(ctx, client, subscriptions, "virtual machine scale sets", "resources | where type =~ 'microsoft.compute/virtualmachinescalesets'",
+				func(subscriptionId string, item azure.VMScaleSet) (AzureWrapper, bool) {
+					return AzureWrapper{
+						Kind: enums.KindAZVMScaleSet,
+						Data: models.VMScaleSet{
+							VMScaleSet:      item,
+							SubscriptionId:  "/subscriptions/" + subscriptionId,
+							ResourceGroupId: item.ResourceGroupId(),
+							TenantId:        client.TenantInfo().TenantId,
+						},
+					}, true
+				})
+		},
+		webApps: func(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+			return listResourceGraph(ctx, client, subscriptions, "web apps", "resources | where type =~ 'microsoft.web/sites' and kind =~ 'app'",
+				func(subscriptionId string, item azure.WebApp) (AzureWrapper, bool) {
+					if item.Kind != "app" {
+						return AzureWrapper{}, false
+					}
+					return AzureWrapper{
+						Kind: enums.KindAZWebApp,
+						Data: models.WebApp{
+							WebApp:            item,
+							SubscriptionId:    "/subscriptions/" + subscriptionId,
+							ResourceGroupId:   item.ResourceGroupId(),
+							ResourceGroupName: item.ResourceGroupName(),
+							TenantId:          client.TenantInfo().TenantId,
+							SiteSettings:      listSiteSettings(ctx, client, item.Id),
+						},
+					}, true
+				})
+		},
+	}
+}
+
+// listResourceGraph lists the resources matching a Resource Graph query across all subscriptions and converts each row
+// into the model produced by the per-subscription listing of the same type. The conversion runs concurrently as it may
+// enrich a resource with further requests.
+func listResourceGraph[T any](
+	ctx context.Context,
+	client client.AzureClient,
+	subscriptions <-chan interface{},
+	description string,
+	kql string,
+	wrap func(subscriptionId string, item T) (AzureWrapper, bool),
+) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		rows    = make(chan json.RawMessage)
+		streams = pipeline.Demux(ctx.Done(), rows, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+		count   atomic.Int64
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(rows)
+
+		var ids []string
+		for result := range pipeline.OrDone(ctx.Done(), subscriptions) {
+			if subscription, ok := result.(AzureWrapper).Data.(models.Subscription); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating "+description, "result", result)
+				return
+			} else {
+				ids = append(ids, subscription.SubscriptionId)
+			}
+		}
+
+		if len(ids) == 0 {
+			return
+		}
+
+		for item := range client.ListAzureResourceGraph(ctx, kql, ids) {
+			if item.Error != nil {
+				log.Error(item.Error, "unable to continue processing "+description+" from resource graph")
+				return
+			} else if ok := pipeline.Send(ctx.Done(), rows, item.Ok); !ok {
+				return
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for row := range stream {
+				var (
+					resource struct {
+						Id             string `json:"id"`
+						SubscriptionId string `json:"subscriptionId"`
+					}
+					item T
+				)
+				if err := json.Unmarshal(row, &resource); err != nil {
+					log.Error(err, "unable to parse resource graph row", "type", description)
+				} else if err := json.Unmarshal(row, &item); err != nil {
+					log.Error(err, "unable to parse resource graph row", "type", description)
+				} else if wrapper, ok := wrap(resource.SubscriptionId, item); ok {
+					log.V(2).Info("found resource", "type", description, "id", resource.Id)
+					count.Add(1)
+					if ok := pipeline.SendAny(ctx.Done(), out, wrapper); !ok {
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all "+description, "count", count.Load())
+	}()
+
+	return out
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListResourceGraphVirtualMachines(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockSubscriptionsChannel := make(chan interface{})
+	mockRowsChannel := make(chan client.AzureResult[json.RawMessage])
+
+	mockTenant := azure.Tenant{TenantId: "tenant"}
+	mockError := fmt.Errorf("I'm an error")
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureResourceGraph(gomock.Any(), "resources | where type =~ 'microsoft.compute/virtualmachines'", []string{"sub1", "sub2"}).Return(mockRowsChannel).Times(1)
+	channel := newSubscriptionResourceListers(true).virtualMachines(ctx, mockClient, mockSubscriptionsChannel)
+
+	go func() {
+		defer close(mockSubscriptionsChannel)
+		for _, id := range []string{"sub1", "sub2"} {
+			mockSubscriptionsChannel <- AzureWrapper{
+				Data: models.Subscription{
+					Subscription: azure.Subscription{SubscriptionId: id},
+				},
+			}
+		}
+	}()
+	go func() {
+		defer close(mockRowsChannel)
+		mockRowsChannel <- client.AzureResult[json.RawMessage]{
+			Ok: json.RawMessage(`{"id":"/subscriptions/sub2/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm","name":"vm","type":"microsoft.compute/virtualmachines","subscriptionId":"sub2","properties":{"vmId":"vm-id"}}`),
+		}
+		mockRowsChannel <- client.AzureResult[json.RawMessage]{
+			Error: mockError,
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if wrapper.Kind != enums.KindAZVM {
+		t.Errorf("unexpected kind: got %s, want %s", wrapper.Kind, enums.KindAZVM)
+	} else if data, ok := wrapper.Data.(models.VirtualMachine); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.VirtualMachine{})
+	} else if data.SubscriptionId != "/subscriptions/sub2" {
+		t.Errorf("unexpected subscription id: got %s, want /subscriptions/sub2", data.SubscriptionId)
+	} else if data.ResourceGroupId != "/subscriptions/sub2/resourceGroups/rg" {
+		t.Errorf("unexpected resource group id: got %s", data.ResourceGroupId)
+	} else if data.Properties.VMId != "vm-id" {
+		t.Errorf("unexpected vm id: got %s, want vm-id", data.Properties.VMId)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
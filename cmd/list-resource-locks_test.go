@@ -0,0 +1,96 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListResourceLocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockSubscriptionsChannel := make(chan interface{})
+	mockLocksChannel := make(chan client.AzureResult[azure.ManagementLock])
+
+	mockTenant := azure.Tenant{}
+	mockError := fmt.Errorf("I'm an error")
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureManagementLocks(gomock.Any(), "sub").Return(mockLocksChannel).Times(1)
+	channel := listResourceLocks(ctx, mockClient, mockSubscriptionsChannel)
+
+	want := map[string]string{
+		"/subscriptions/sub/providers/Microsoft.Authorization/locks/subscription-lock":                                                      "/subscriptions/sub",
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Authorization/locks/rg-lock":                                              "/subscriptions/sub/resourceGroups/rg",
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/kv/providers/microsoft.authorization/locks/resource-lock": "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/kv",
+	}
+
+	go func() {
+		defer close(mockSubscriptionsChannel)
+		mockSubscriptionsChannel <- AzureWrapper{
+			Data: models.Subscription{
+				Subscription: azure.Subscription{SubscriptionId: "sub"},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockLocksChannel)
+		for id := range want {
+			mockLocksChannel <- client.AzureResult[azure.ManagementLock]{
+				Ok: azure.ManagementLock{Entity: azure.Entity{Id: id}},
+			}
+		}
+		mockLocksChannel <- client.AzureResult[azure.ManagementLock]{
+			Error: mockError,
+		}
+	}()
+
+	for i := 0; i < len(want); i++ {
+		if result, ok := <-channel; !ok {
+			t.Fatalf("failed to receive from channel")
+		} else if wrapper, ok := result.(AzureWrapper); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+		} else if wrapper.Kind != enums.KindAZResourceLock {
+			t.Errorf("unexpected kind: got %s, want %s", wrapper.Kind, enums.KindAZResourceLock)
+		} else if data, ok := wrapper.Data.(models.ResourceLock); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.ResourceLock{})
+		} else if data.LockedResourceId != want[data.Id] {
+			t.Errorf("unexpected locked resource id: got %s, want %s", data.LockedResourceId, want[data.Id])
+		}
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+// Resource types with a dedicated collector, which the generic resource collector skips so that each resource is emitted
+// under a single kind.
+var dedicatedResourceTypes = map[string]bool{
+	"microsoft.automation/automationaccounts":      true,
+	"microsoft.cognitiveservices/accounts":         true,
+	"microsoft.compute/virtualmachines":            true,
+	"microsoft.compute/virtualmachinescalesets":    true,
+	"microsoft.containerregistry/registries":       true,
+	"microsoft.containerservice/managedclusters":   true,
+	"microsoft.keyvault/vaults":                    true,
+	"microsoft.logic/workflows":                    true,
+	"microsoft.machinelearningservices/workspaces": true,
+	"microsoft.storage/storageaccounts":            true,
+}
+
+// Resource types whose dedicated collectors only emit some of their kinds, which are the only ones the generic resource
+// collector skips, e.g. Linux and container web apps are not emitted as AZWebApp.
+var dedicatedResourceKinds = map[string][]string{
+	"microsoft.web/sites": {"app", "functionapp"},
+}
+
+func isDedicatedResource(resource azure.Resource) bool {
+	resourceType := strings.ToLower(resource.Type)
+	if kinds, ok := dedicatedResourceKinds[resourceType]; ok {
+		return slices.Contains(kinds, resource.Kind)
+	}
+	return dedicatedResourceTypes[resourceType]
+}
+
+func init() {
+	listRootCmd.AddCommand(listResourcesCmd)
+}
+
+var listResourcesCmd = &cobra.Command{
+	Use:          "resources",
+	Long:         "Lists Azure Resources of any type with a Managed Identity attached",
+	Run:          listResourcesCmdImpl,
+	SilenceUsage: true,
+}
+
+func listResourcesCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure resources...")
+	start := time.Now()
+	stream := listResources(ctx, azClient, listSubscriptions(ctx, azClient))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listResources(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan string)
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+		for result := range pipeline.OrDone(ctx.Done(), subscriptions) {
+			if subscription, ok := result.(AzureWrapper).Data.(models.Subscription); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating resources", "result", result)
+				return
+			} else {
+				if ok := pipeline.Send(ctx.Done(), ids, subscription.SubscriptionId); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				count := 0
+				for item := range client.ListAzureResources(ctx, id, query.RMParams{}) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing resources for this subscription", "subscriptionId", id)
+					} else if !item.Ok.HasManagedIdentity() || isDedicatedResource(item.Ok) {
+						continue
+					} else {
+						resource := models.Resource{
+							Resource:          item.Ok,
+							SubscriptionId:    "/subscriptions/" + id,
+							ResourceGroupId:   item.Ok.ResourceGroupId(),
+							ResourceGroupName: item.Ok.ResourceGroupName(),
+							TenantId:          client.TenantInfo().TenantId,
+						}
+						log.V(2).Info("found resource", "name", resource.Name, "type", resource.Type)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZResource,
+							Data: resource,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing resources", "subscriptionId", id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all resources")
+	}()
+
+	return out
+}
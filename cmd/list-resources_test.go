@@ -0,0 +1,114 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockSubscriptionsChannel := make(chan interface{})
+	mockResourcesChannel := make(chan client.AzureResult[azure.Resource])
+
+	subscription := models.Subscription{}
+	subscription.SubscriptionId = "sub-1"
+
+	eventHub := azure.Resource{Type: "Microsoft.EventHub/namespaces"}
+	eventHub.Id = "/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.EventHub/namespaces/hub-1"
+	eventHub.Identity.Type = enums.IdentitySystemAssigned
+	eventHub.Identity.PrincipalId = "principal-1"
+
+	withoutIdentity := azure.Resource{Type: "Microsoft.Network/virtualNetworks"}
+	withoutIdentity.Identity.Type = enums.IdentityNone
+
+	virtualMachine := azure.Resource{Type: "Microsoft.Compute/virtualMachines"}
+	virtualMachine.Identity.Type = enums.IdentitySystemAssigned
+
+	// Only the kinds emitted by the web app and function app collectors are skipped
+	webApp := azure.Resource{Type: "Microsoft.Web/sites", Kind: "app"}
+	webApp.Identity.Type = enums.IdentitySystemAssigned
+
+	linuxWebApp := azure.Resource{Type: "Microsoft.Web/sites", Kind: "app,linux"}
+	linuxWebApp.Id = "/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.Web/sites/site-1"
+	linuxWebApp.Identity.Type = enums.IdentitySystemAssigned
+	linuxWebApp.Identity.PrincipalId = "principal-2"
+
+	mockTenant := azure.Tenant{}
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureResources(gomock.Any(), subscription.SubscriptionId, gomock.Any()).Return(mockResourcesChannel).Times(1)
+	channel := listResources(ctx, mockClient, mockSubscriptionsChannel)
+
+	go func() {
+		defer close(mockSubscriptionsChannel)
+		mockSubscriptionsChannel <- AzureWrapper{
+			Data: subscription,
+		}
+	}()
+	go func() {
+		defer close(mockResourcesChannel)
+		for _, resource := range []azure.Resource{eventHub, withoutIdentity, virtualMachine, webApp, linuxWebApp} {
+			mockResourcesChannel <- client.AzureResult[azure.Resource]{
+				Ok: resource,
+			}
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Fatalf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if wrapper.Kind != enums.KindAZResource {
+		t.Errorf("got kind %s, want %s", wrapper.Kind, enums.KindAZResource)
+	} else if data, ok := wrapper.Data.(models.Resource); !ok {
+		t.Fatalf("failed type assertion: got %T, want %T", wrapper.Data, models.Resource{})
+	} else if data.Id != eventHub.Id || data.ResourceGroupId != "/subscriptions/sub-1/resourceGroups/rg-1" {
+		t.Errorf("unexpected resource: %+v", data)
+	}
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Fatalf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.Resource); !ok {
+		t.Fatalf("failed type assertion: got %T, want %T", wrapper.Data, models.Resource{})
+	} else if data.Id != linuxWebApp.Id || data.Kind != "app,linux" {
+		t.Errorf("unexpected resource: %+v", data)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have received from channel")
+	}
+}
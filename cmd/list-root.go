@@ -31,7 +31,7 @@ import (
 )
 
 func init() {
-	config.Init(listRootCmd, append(config.AzureConfig, config.OutputFile))
+	config.Init(listRootCmd, append(config.AzureConfig, config.OutputFile, config.Incremental))
 	rootCmd.AddCommand(listRootCmd)
 }
 
@@ -53,17 +53,22 @@ func listCmdImpl(cmd *cobra.Command, args []string) {
 
 	log.V(1).Info("testing connections")
 	azClient := connectAndCreateClient()
+	delta, err := openDeltaState(azClient, config.Incremental.Value().(bool))
+	if err != nil {
+		exit(err)
+	}
 	log.Info("collecting azure objects...")
 	start := time.Now()
-	stream := listAll(ctx, azClient)
+	stream := listAll(ctx, azClient, delta)
 	outputStream(ctx, stream)
+	saveDeltaState(ctx, delta)
 	duration := time.Since(start)
 	log.Info("collection completed", "duration", duration.String())
 }
 
-func listAll(ctx context.Context, client client.AzureClient) <-chan interface{} {
+func listAll(ctx context.Context, client client.AzureClient, delta *deltaState) <-chan interface{} {
 	var (
-		azureAD = listAllAD(ctx, client)
+		azureAD = listAllAD(ctx, client, delta)
 		azureRM = listAllRM(ctx, client)
 	)
 	return pipeline.Mux(ctx.Done(), azureAD, azureRM)
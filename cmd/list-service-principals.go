@@ -27,6 +27,7 @@ import (
 	"github.com/bloodhoundad/azurehound/v2/client/query"
 	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
 	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
 	"github.com/bloodhoundad/azurehound/v2/pipeline"
 	"github.com/spf13/cobra"
@@ -89,3 +90,19 @@ func listServicePrincipals(ctx context.Context, client client.AzureClient) <-cha
 
 	return out
 }
+
+func listServicePrincipalsDelta(ctx context.Context, client client.AzureClient, delta *deltaState) (<-chan interface{}, <-chan interface{}) {
+	return listDelta(ctx, client, delta, enums.KindAZServicePrincipal,
+		client.ListAzureADServicePrincipalsDelta, query.GraphParams{},
+		func(item azure.DeltaItem[azure.ServicePrincipal]) (interface{}, bool) {
+			return AzureWrapper{
+				Kind: enums.KindAZServicePrincipal,
+				Data: models.ServicePrincipal{
+					ServicePrincipal: item.Object,
+					TenantId:         client.TenantInfo().TenantId,
+					TenantName:       client.TenantInfo().DisplayName,
+				},
+			}, true
+		},
+	)
+}
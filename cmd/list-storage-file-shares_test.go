@@ -0,0 +1,99 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListStorageFileShares(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockStorageAccountsChannel := make(chan interface{})
+	mockFileShareChannel := make(chan client.AzureResult[azure.StorageFileShare])
+	mockFileShareChannel2 := make(chan client.AzureResult[azure.StorageFileShare])
+
+	sharedKeyDisabled := false
+	defaultAccount := models.StorageAccount{}
+	defaultAccount.Id = "default-account"
+	restrictedAccount := models.StorageAccount{}
+	restrictedAccount.Id = "restricted-account"
+	restrictedAccount.Properties.AllowSharedKeyAccess = &sharedKeyDisabled
+
+	mockTenant := azure.Tenant{}
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureStorageFileShares(gomock.Any(), defaultAccount.Id).Return(mockFileShareChannel).Times(1)
+	mockClient.EXPECT().ListAzureStorageFileShares(gomock.Any(), restrictedAccount.Id).Return(mockFileShareChannel2).Times(1)
+	channel := listStorageFileShares(ctx, mockClient, mockStorageAccountsChannel)
+
+	go func() {
+		defer close(mockStorageAccountsChannel)
+		mockStorageAccountsChannel <- AzureWrapper{
+			Data: defaultAccount,
+		}
+		mockStorageAccountsChannel <- AzureWrapper{
+			Data: restrictedAccount,
+		}
+	}()
+	go func() {
+		defer close(mockFileShareChannel)
+		mockFileShareChannel <- client.AzureResult[azure.StorageFileShare]{
+			Ok: azure.StorageFileShare{},
+		}
+	}()
+	go func() {
+		defer close(mockFileShareChannel2)
+		mockFileShareChannel2 <- client.AzureResult[azure.StorageFileShare]{
+			Ok: azure.StorageFileShare{},
+		}
+	}()
+
+	results := map[string]bool{}
+	for result := range channel {
+		if wrapper, ok := result.(AzureWrapper); !ok {
+			t.Fatalf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+		} else if fileShare, ok := wrapper.Data.(models.StorageFileShare); !ok {
+			t.Fatalf("failed type assertion: got %T, want %T", wrapper.Data, models.StorageFileShare{})
+		} else {
+			results[fileShare.StorageAccountId] = fileShare.AllowSharedKeyAccess
+		}
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected file shares from 2 storage accounts, got %d", len(results))
+	} else if !results[defaultAccount.Id] {
+		t.Error("expected shared key access to be allowed when the account does not set allowSharedKeyAccess")
+	} else if results[restrictedAccount.Id] {
+		t.Error("expected shared key access to be disallowed when the account sets allowSharedKeyAccess to false")
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listStorageQueuesCmd)
+}
+
+var listStorageQueuesCmd = &cobra.Command{
+	Use:          "storage-queues",
+	Long:         "Lists Azure Storage Queues",
+	Run:          listStorageQueuesCmdImpl,
+	SilenceUsage: true,
+}
+
+func listStorageQueuesCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure storage queues...")
+	start := time.Now()
+	subscriptions := listSubscriptions(ctx, azClient)
+	storageAccounts := listStorageAccounts(ctx, azClient, subscriptions)
+	stream := listStorageQueues(ctx, azClient, storageAccounts)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listStorageQueues(ctx context.Context, client client.AzureClient, storageAccounts <-chan interface{}) <-chan interface{} {
+	var (
+		out      = make(chan interface{})
+		accounts = make(chan interface{})
+		streams  = pipeline.Demux(ctx.Done(), accounts, config.ColStreamCount.Value().(int))
+		wg       sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(accounts)
+		for result := range pipeline.OrDone(ctx.Done(), storageAccounts) {
+			if storageAccount, ok := result.(AzureWrapper).Data.(models.StorageAccount); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating storage queues", "result", result)
+				return
+			} else {
+				if ok := pipeline.SendAny(ctx.Done(), accounts, storageAccount); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for result := range stream {
+				var (
+					storageAccount = result.(models.StorageAccount)
+					count          = 0
+				)
+				for item := range client.ListAzureStorageQueues(ctx, storageAccount.Id) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing queues for this storage account", "storageAccountId", storageAccount.Id)
+					} else {
+						queue := models.StorageQueue{
+							StorageQueue:         item.Ok,
+							AllowSharedKeyAccess: storageAccount.SharedKeyAccessAllowed(),
+							SubscriptionId:       storageAccount.SubscriptionId,
+							ResourceGroupId:      storageAccount.ResourceGroupId,
+							ResourceGroupName:    storageAccount.ResourceGroupName,
+							StorageAccountId:     storageAccount.Id,
+							TenantId:             client.TenantInfo().TenantId,
+						}
+						log.V(2).Info("found storage queue", "name", queue.Name)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZStorageQueue,
+							Data: queue,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing storage queues", "storageAccountId", storageAccount.Id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all storage queues")
+	}()
+
+	return out
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listStorageTablesCmd)
+}
+
+var listStorageTablesCmd = &cobra.Command{
+	Use:          "storage-tables",
+	Long:         "Lists Azure Storage Tables",
+	Run:          listStorageTablesCmdImpl,
+	SilenceUsage: true,
+}
+
+func listStorageTablesCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure storage tables...")
+	start := time.Now()
+	subscriptions := listSubscriptions(ctx, azClient)
+	storageAccounts := listStorageAccounts(ctx, azClient, subscriptions)
+	stream := listStorageTables(ctx, azClient, storageAccounts)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listStorageTables(ctx context.Context, client client.AzureClient, storageAccounts <-chan interface{}) <-chan interface{} {
+	var (
+		out      = make(chan interface{})
+		accounts = make(chan interface{})
+		streams  = pipeline.Demux(ctx.Done(), accounts, config.ColStreamCount.Value().(int))
+		wg       sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(accounts)
+		for result := range pipeline.OrDone(ctx.Done(), storageAccounts) {
+			if storageAccount, ok := result.(AzureWrapper).Data.(models.StorageAccount); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating storage tables", "result", result)
+				return
+			} else {
+				if ok := pipeline.SendAny(ctx.Done(), accounts, storageAccount); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for result := range stream {
+				var (
+					storageAccount = result.(models.StorageAccount)
+					count          = 0
+				)
+				for item := range client.ListAzureStorageTables(ctx, storageAccount.Id) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing tables for this storage account", "storageAccountId", storageAccount.Id)
+					} else {
+						table := models.StorageTable{
+							StorageTable:         item.Ok,
+							AllowSharedKeyAccess: storageAccount.SharedKeyAccessAllowed(),
+							SubscriptionId:       storageAccount.SubscriptionId,
+							ResourceGroupId:      storageAccount.ResourceGroupId,
+							ResourceGroupName:    storageAccount.ResourceGroupName,
+							StorageAccountId:     storageAccount.Id,
+							TenantId:             client.TenantInfo().TenantId,
+						}
+						log.V(2).Info("found storage table", "name", table.Name)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZStorageTable,
+							Data: table,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing storage tables", "storageAccountId", storageAccount.Id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all storage tables")
+	}()
+
+	return out
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listSubscriptionClassicAdminsCmd)
+}
+
+var listSubscriptionClassicAdminsCmd = &cobra.Command{
+	Use:          "subscription-classic-admins",
+	Long:         "Lists Azure Subscription Classic Administrators and Co-Administrators",
+	Run:          listSubscriptionClassicAdminsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listSubscriptionClassicAdminsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure subscription classic admins...")
+	start := time.Now()
+	subscriptions := listSubscriptions(ctx, azClient)
+	stream := listSubscriptionClassicAdmins(ctx, azClient, subscriptions)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listSubscriptionClassicAdmins(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan string)
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		for result := range pipeline.OrDone(ctx.Done(), subscriptions) {
+			if subscription, ok := result.(AzureWrapper).Data.(models.Subscription); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating subscription classic admins", "result", result)
+				return
+			} else {
+				if ok := pipeline.Send(ctx.Done(), ids, subscription.Id); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				var (
+					subscriptionClassicAdmins = models.SubscriptionClassicAdmins{
+						SubscriptionId: id,
+					}
+					count = 0
+				)
+				for item := range client.ListAzureClassicAdministrators(ctx, path.Base(id)) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing classic admins for this subscription", "subscriptionId", id)
+					} else {
+						subscriptionClassicAdmin := models.SubscriptionClassicAdmin{
+							Administrator:  item.Ok,
+							Roles:          item.Ok.Roles(),
+							SubscriptionId: id,
+						}
+						log.V(2).Info("found subscription classic admin", "name", subscriptionClassicAdmin.Administrator.Name, "role", subscriptionClassicAdmin.Administrator.Properties.Role)
+						count++
+						subscriptionClassicAdmins.Admins = append(subscriptionClassicAdmins.Admins, subscriptionClassicAdmin)
+					}
+				}
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZSubscriptionClassicAdmin,
+					Data: subscriptionClassicAdmins,
+				}); !ok {
+					return
+				}
+				log.V(1).Info("finished listing subscription classic admins", "subscriptionId", id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all subscription classic admins")
+	}()
+
+	return out
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListSubscriptionClassicAdmins(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockSubscriptionsChannel := make(chan interface{})
+	mockAdminsChannel := make(chan client.AzureResult[azure.ClassicAdministrator])
+
+	mockError := fmt.Errorf("I'm an error")
+	mockClient.EXPECT().ListAzureClassicAdministrators(gomock.Any(), "subscription").Return(mockAdminsChannel).Times(1)
+	channel := listSubscriptionClassicAdmins(ctx, mockClient, mockSubscriptionsChannel)
+
+	go func() {
+		defer close(mockSubscriptionsChannel)
+		mockSubscriptionsChannel <- AzureWrapper{
+			Data: models.Subscription{
+				Subscription: azure.Subscription{Entity: azure.Entity{Id: "/subscriptions/subscription"}},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockAdminsChannel)
+		mockAdminsChannel <- client.AzureResult[azure.ClassicAdministrator]{
+			Ok: azure.ClassicAdministrator{
+				Properties: azure.ClassicAdministratorProperties{
+					EmailAddress: "admin@example.com",
+					Role:         "ServiceAdministrator;AccountAdministrator",
+				},
+			},
+		}
+		mockAdminsChannel <- client.AzureResult[azure.ClassicAdministrator]{
+			Error: mockError,
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if wrapper.Kind != enums.KindAZSubscriptionClassicAdmin {
+		t.Errorf("unexpected kind: got %s, want %s", wrapper.Kind, enums.KindAZSubscriptionClassicAdmin)
+	} else if data, ok := wrapper.Data.(models.SubscriptionClassicAdmins); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.SubscriptionClassicAdmins{})
+	} else if len(data.Admins) != 1 {
+		t.Errorf("got %v admins, want %v", len(data.Admins), 1)
+	} else if roles := data.Admins[0].Roles; len(roles) != 2 || roles[0] != "ServiceAdministrator" || roles[1] != "AccountAdministrator" {
+		t.Errorf("unexpected roles: %v", roles)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/bloodhoundad/azurehound/v2/client/query"
 	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
 	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
 	"github.com/bloodhoundad/azurehound/v2/pipeline"
 	"github.com/spf13/cobra"
@@ -59,23 +60,25 @@ func listUsersCmdImpl(cmd *cobra.Command, _ []string) {
 	log.Info("collection completed", "duration", duration.String())
 }
 
+var userSelectColumns = []string{
+	"accountEnabled",
+	"createdDateTime",
+	"displayName",
+	"jobTitle",
+	"lastPasswordChangeDateTime",
+	"mail",
+	"onPremisesSecurityIdentifier",
+	"onPremisesSyncEnabled",
+	"userPrincipalName",
+	"userType",
+	"id",
+}
+
 func listUsers(ctx context.Context, client client.AzureClient) <-chan interface{} {
 	out := make(chan interface{})
 
 	makeParams := func(includeSignInActivity bool) query.GraphParams {
-		selectCols := []string{
-			"accountEnabled",
-			"createdDateTime",
-			"displayName",
-			"jobTitle",
-			"lastPasswordChangeDateTime",
-			"mail",
-			"onPremisesSecurityIdentifier",
-			"onPremisesSyncEnabled",
-			"userPrincipalName",
-			"userType",
-			"id",
-		}
+		selectCols := append([]string{}, userSelectColumns...)
 		if includeSignInActivity {
 			selectCols = append(selectCols, "signInActivity")
 		}
@@ -127,6 +130,23 @@ func listUsers(ctx context.Context, client client.AzureClient) <-chan interface{
 	return out
 }
 
+// signInActivity is not supported by the users delta query
+func listUsersDelta(ctx context.Context, client client.AzureClient, delta *deltaState) (<-chan interface{}, <-chan interface{}) {
+	return listDelta(ctx, client, delta, enums.KindAZUser,
+		client.ListAzureADUsersDelta, query.GraphParams{Select: userSelectColumns},
+		func(item azure.DeltaItem[azure.User]) (interface{}, bool) {
+			return AzureWrapper{
+				Kind: enums.KindAZUser,
+				Data: models.User{
+					User:       item.Object,
+					TenantId:   client.TenantInfo().TenantId,
+					TenantName: client.TenantInfo().DisplayName,
+				},
+			}, true
+		},
+	)
+}
+
 func isGraphAuthorizationDenied(err error) bool {
 	if err == nil {
 		return false
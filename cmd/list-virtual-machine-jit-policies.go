@@ -0,0 +1,177 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listVirtualMachineJitPoliciesCmd)
+}
+
+var listVirtualMachineJitPoliciesCmd = &cobra.Command{
+	Use:          "virtual-machine-jit-policies",
+	Long:         "Lists the Defender for Cloud just-in-time access policies protecting Azure Virtual Machines",
+	Run:          listVirtualMachineJitPoliciesCmdImpl,
+	SilenceUsage: true,
+}
+
+func listVirtualMachineJitPoliciesCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure virtual machine jit policies...")
+	start := time.Now()
+	var (
+		subscriptions  = make(chan interface{})
+		subscriptions2 = make(chan interface{})
+	)
+	pipeline.Tee(ctx.Done(), listSubscriptions(ctx, azClient), subscriptions, subscriptions2)
+	stream := listVirtualMachineJitPolicies(ctx, azClient, subscriptions, listVirtualMachines(ctx, azClient, subscriptions2))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listVirtualMachineJitPolicies links the just-in-time access policies of each subscription to the virtual machines
+// they protect. Policy entries for virtual machines that were not collected, e.g. because they have since been deleted,
+// are skipped. Both inputs are drained concurrently so that upstream tees sharing a source never block on one another.
+func listVirtualMachineJitPolicies(ctx context.Context, client client.AzureClient, subscriptions, virtualMachines <-chan interface{}) <-chan interface{} {
+	var (
+		out      = make(chan interface{})
+		ids      = make(chan string)
+		streams  = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		vms      []models.VirtualMachine
+		policies []models.VirtualMachineJitPolicy
+		mutex    sync.Mutex
+		wg       sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		for result := range pipeline.OrDone(ctx.Done(), subscriptions) {
+			if subscription, ok := result.(AzureWrapper).Data.(models.Subscription); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating virtual machine jit policies", "result", result)
+				return
+			} else {
+				if ok := pipeline.Send(ctx.Done(), ids, subscription.SubscriptionId); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams) + 1)
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer wg.Done()
+		vms = collectAzureWrapperData[models.VirtualMachine](ctx, virtualMachines)
+	}()
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				count := 0
+				for item := range client.ListAzureJitNetworkAccessPolicies(ctx, id) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing jit network access policies for this subscription", "subscriptionId", id)
+					} else {
+						log.V(2).Info("found jit network access policy", "name", item.Ok.Name, "location", item.Ok.Location)
+						count++
+						mutex.Lock()
+						policies = append(policies, virtualMachineJitPolicies(item.Ok, "/subscriptions/"+id, client.TenantInfo().TenantId)...)
+						mutex.Unlock()
+					}
+				}
+				log.V(1).Info("finished listing jit network access policies", "subscriptionId", id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(out)
+		wg.Wait()
+
+		var (
+			vmIds = make(map[string]bool, len(vms))
+			count = 0
+		)
+		for _, vm := range vms {
+			vmIds[strings.ToLower(vm.Id)] = true
+		}
+
+		for _, policy := range policies {
+			if !vmIds[strings.ToLower(policy.VirtualMachineId)] {
+				log.V(2).Info("skipping jit policy for unknown virtual machine", "name", policy.JitNetworkAccessPolicyName, "virtualMachineId", policy.VirtualMachineId)
+				continue
+			}
+			log.V(2).Info("found virtual machine jit policy", "name", policy.JitNetworkAccessPolicyName, "virtualMachineId", policy.VirtualMachineId)
+			count++
+			if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+				Kind: enums.KindAZVMJitPolicy,
+				Data: policy,
+			}); !ok {
+				return
+			}
+		}
+		log.Info("finished listing all virtual machine jit policies", "count", count)
+	}()
+
+	return out
+}
+
+func virtualMachineJitPolicies(policy azure.JitNetworkAccessPolicy, subscriptionId, tenantId string) []models.VirtualMachineJitPolicy {
+	result := make([]models.VirtualMachineJitPolicy, 0, len(policy.Properties.VirtualMachines))
+	for _, vm := range policy.Properties.VirtualMachines {
+		result = append(result, models.VirtualMachineJitPolicy{
+			JitNetworkAccessPolicyId:   policy.Id,
+			JitNetworkAccessPolicyName: policy.Name,
+			Location:                   policy.Location,
+			Ports:                      vm.Ports,
+			PublicIpAddress:            vm.PublicIpAddress,
+			VirtualMachineId:           vm.Id,
+			SubscriptionId:             subscriptionId,
+			TenantId:                   tenantId,
+		})
+	}
+	return result
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListVirtualMachineJitPolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	const vmId = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm"
+
+	mockSubscriptionsChannel := make(chan interface{})
+	mockVirtualMachinesChannel := make(chan interface{})
+	mockPoliciesChannel := make(chan client.AzureResult[azure.JitNetworkAccessPolicy])
+
+	mockTenant := azure.Tenant{}
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureJitNetworkAccessPolicies(gomock.Any(), "sub").Return(mockPoliciesChannel).Times(1)
+	channel := listVirtualMachineJitPolicies(ctx, mockClient, mockSubscriptionsChannel, mockVirtualMachinesChannel)
+
+	go func() {
+		defer close(mockSubscriptionsChannel)
+		mockSubscriptionsChannel <- AzureWrapper{
+			Data: models.Subscription{
+				Subscription: azure.Subscription{SubscriptionId: "sub"},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockVirtualMachinesChannel)
+		mockVirtualMachinesChannel <- AzureWrapper{
+			Data: models.VirtualMachine{
+				VirtualMachine: azure.VirtualMachine{Entity: azure.Entity{Id: vmId}},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockPoliciesChannel)
+		policy := azure.JitNetworkAccessPolicy{Name: "default", Location: "westus"}
+		policy.Properties.VirtualMachines = []azure.JitNetworkAccessPolicyVirtualMachine{
+			{
+				Id:    "/subscriptions/sub/resourcegroups/RG/providers/Microsoft.Compute/virtualMachines/VM",
+				Ports: []azure.JitNetworkAccessPortRule{{Number: 22, Protocol: "*", AllowedSourceAddressPrefix: "*", MaxRequestAccessDuration: "PT3H"}},
+			},
+			{
+				Id:    "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/deleted",
+				Ports: []azure.JitNetworkAccessPortRule{{Number: 3389}},
+			},
+		}
+		mockPoliciesChannel <- client.AzureResult[azure.JitNetworkAccessPolicy]{Ok: policy}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if wrapper.Kind != enums.KindAZVMJitPolicy {
+		t.Errorf("unexpected kind: got %s, want %s", wrapper.Kind, enums.KindAZVMJitPolicy)
+	} else if data, ok := wrapper.Data.(models.VirtualMachineJitPolicy); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.VirtualMachineJitPolicy{})
+	} else if len(data.Ports) != 1 || data.Ports[0].Number != 22 || data.Ports[0].AllowedSourceAddressPrefix != "*" {
+		t.Errorf("unexpected ports: %+v", data.Ports)
+	} else if data.SubscriptionId != "/subscriptions/sub" {
+		t.Errorf("unexpected subscription id: got %s, want %s", data.SubscriptionId, "/subscriptions/sub")
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
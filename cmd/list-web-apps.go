@@ -108,6 +108,7 @@ func listWebApps(ctx context.Context, client client.AzureClient, subscriptions <
 							TenantId:          client.TenantInfo().TenantId,
 						}
 						if webApp.Kind == "app" {
+							webApp.SiteSettings = listSiteSettings(ctx, client, webApp.Id)
 							log.V(2).Info("found web app", "name", webApp.Name)
 							count++
 							if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
@@ -132,3 +133,68 @@ func listWebApps(ctx context.Context, client client.AzureClient, subscriptions <
 
 	return out
 }
+
+// listSiteSettings fetches the deployment credential, authentication and deployment source settings shared by web apps
+// and function apps concurrently, unless opted out of. Settings that cannot be read are left unset.
+func listSiteSettings(ctx context.Context, client client.AzureClient, siteId string) models.SiteSettings {
+	var (
+		settings models.SiteSettings
+		wg       sync.WaitGroup
+	)
+
+	if !config.AzSiteSettings.Value().(bool) {
+		return settings
+	}
+
+	wg.Add(4)
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer wg.Done()
+		for item := range client.ListAzureSitePublishingCredentialsPolicies(ctx, siteId) {
+			if item.Error != nil {
+				log.Error(item.Error, "unable to continue processing basic publishing credentials policies for this site", "siteId", siteId)
+			} else {
+				allow := item.Ok.Properties.Allow
+				switch item.Ok.Name {
+				case "ftp":
+					settings.FtpBasicAuthEnabled = &allow
+				case "scm":
+					settings.ScmBasicAuthEnabled = &allow
+				}
+			}
+		}
+	}()
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer wg.Done()
+		if authSettings, err := client.GetAzureSiteAuthSettingsV2(ctx, siteId); err != nil {
+			log.Error(err, "unable to fetch auth settings for this site", "siteId", siteId)
+		} else {
+			settings.AuthSettings = &authSettings
+		}
+	}()
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer wg.Done()
+		if siteConfig, err := client.GetAzureSiteConfig(ctx, siteId); err != nil {
+			log.Error(err, "unable to fetch site config for this site", "siteId", siteId)
+		} else {
+			settings.ScmType = siteConfig.Properties.ScmType
+		}
+	}()
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer wg.Done()
+		if sourceControl, err := client.GetAzureSiteSourceControl(ctx, siteId); err != nil {
+			log.V(1).Info("unable to fetch source control for this site", "siteId", siteId, "err", err)
+		} else if sourceControl.IsConfigured() {
+			settings.SourceControl = &sourceControl
+		}
+	}()
+
+	wg.Wait()
+	return settings
+}
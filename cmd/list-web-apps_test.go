@@ -0,0 +1,175 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListWebApps(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockSubscriptionsChannel := make(chan interface{})
+	mockWebAppsChannel := make(chan client.AzureResult[azure.WebApp])
+	mockPoliciesChannel := make(chan client.AzureResult[azure.SitePublishingCredentialsPolicy])
+
+	subscription := models.Subscription{}
+	subscription.SubscriptionId = "sub-1"
+
+	webApp := azure.WebApp{Kind: "app"}
+	webApp.Id = "/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.Web/sites/site-1"
+
+	ftp := azure.SitePublishingCredentialsPolicy{Name: "ftp"}
+	scm := azure.SitePublishingCredentialsPolicy{Name: "scm"}
+	scm.Properties.Allow = true
+
+	authSettings := azure.SiteAuthSettingsV2{}
+	authSettings.Properties.Platform.Enabled = true
+
+	siteConfig := azure.SiteConfigResource{}
+	siteConfig.Properties.ScmType = enums.GitHubScm
+
+	mockTenant := azure.Tenant{}
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureWebApps(gomock.Any(), subscription.SubscriptionId).Return(mockWebAppsChannel).Times(1)
+	mockClient.EXPECT().ListAzureSitePublishingCredentialsPolicies(gomock.Any(), webApp.Id).Return(mockPoliciesChannel).Times(1)
+	mockClient.EXPECT().GetAzureSiteAuthSettingsV2(gomock.Any(), webApp.Id).Return(authSettings, nil).Times(1)
+	mockClient.EXPECT().GetAzureSiteConfig(gomock.Any(), webApp.Id).Return(siteConfig, nil).Times(1)
+	mockClient.EXPECT().GetAzureSiteSourceControl(gomock.Any(), webApp.Id).Return(azure.SiteSourceControl{}, fmt.Errorf("not found")).Times(1)
+	channel := listWebApps(ctx, mockClient, mockSubscriptionsChannel)
+
+	go func() {
+		defer close(mockSubscriptionsChannel)
+		mockSubscriptionsChannel <- AzureWrapper{
+			Data: subscription,
+		}
+	}()
+	go func() {
+		defer close(mockWebAppsChannel)
+		mockWebAppsChannel <- client.AzureResult[azure.WebApp]{
+			Ok: webApp,
+		}
+	}()
+	go func() {
+		defer close(mockPoliciesChannel)
+		mockPoliciesChannel <- client.AzureResult[azure.SitePublishingCredentialsPolicy]{
+			Ok: ftp,
+		}
+		mockPoliciesChannel <- client.AzureResult[azure.SitePublishingCredentialsPolicy]{
+			Ok: scm,
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Fatalf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.WebApp); !ok {
+		t.Fatalf("failed type assertion: got %T, want %T", wrapper.Data, models.WebApp{})
+	} else {
+		if data.FtpBasicAuthEnabled == nil || *data.FtpBasicAuthEnabled {
+			t.Errorf("expected ftp basic auth to be disabled, got %v", data.FtpBasicAuthEnabled)
+		}
+		if data.ScmBasicAuthEnabled == nil || !*data.ScmBasicAuthEnabled {
+			t.Errorf("expected scm basic auth to be enabled, got %v", data.ScmBasicAuthEnabled)
+		}
+		if data.AuthSettings == nil || !data.AuthSettings.Properties.Platform.Enabled {
+			t.Errorf("expected auth settings to be enabled, got %+v", data.AuthSettings)
+		}
+		if data.ScmType != enums.GitHubScm {
+			t.Errorf("got scm type %s, want %s", data.ScmType, enums.GitHubScm)
+		}
+		if data.SourceControl != nil {
+			t.Errorf("expected source control to be unset when it cannot be read, got %+v", data.SourceControl)
+		}
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have received from channel")
+	}
+}
+
+func TestListWebAppsWithoutSiteSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	config.AzSiteSettings.Set(false)
+	defer config.AzSiteSettings.Set(true)
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockSubscriptionsChannel := make(chan interface{})
+	mockWebAppsChannel := make(chan client.AzureResult[azure.WebApp])
+
+	subscription := models.Subscription{}
+	subscription.SubscriptionId = "sub-1"
+
+	webApp := azure.WebApp{Kind: "app"}
+	webApp.Id = "/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.Web/sites/site-1"
+
+	// No site settings are requested when opted out of
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzureWebApps(gomock.Any(), subscription.SubscriptionId).Return(mockWebAppsChannel).Times(1)
+	channel := listWebApps(ctx, mockClient, mockSubscriptionsChannel)
+
+	go func() {
+		defer close(mockSubscriptionsChannel)
+		mockSubscriptionsChannel <- AzureWrapper{
+			Data: subscription,
+		}
+	}()
+	go func() {
+		defer close(mockWebAppsChannel)
+		mockWebAppsChannel <- client.AzureResult[azure.WebApp]{
+			Ok: webApp,
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Fatalf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.WebApp); !ok {
+		t.Fatalf("failed type assertion: got %T, want %T", wrapper.Data, models.WebApp{})
+	} else if data.AuthSettings != nil || data.FtpBasicAuthEnabled != nil || data.ScmType != "" {
+		t.Errorf("expected site settings to be unset, got %+v", data.SiteSettings)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have received from channel")
+	}
+}
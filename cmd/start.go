@@ -129,7 +129,8 @@ func start(ctx context.Context) {
 								log.V(2).Info("there are no jobs for azurehound to complete at this time")
 							} else {
 								defer currentJobID.Store(0)
-								queuedJobID := executableJobs[0].ID
+								queuedJob := executableJobs[0]
+								queuedJobID := queuedJob.ID
 								currentJobID.Store(int64(queuedJobID))
 								// Notify BHE instance of job start
 								if err := bheClient.StartJob(ctx, queuedJobID); err != nil {
@@ -139,10 +140,18 @@ func start(ctx context.Context) {
 
 								start := time.Now()
 
+								delta, err := openDeltaState(azClient, queuedJob.IncrementalCollection)
+								if err != nil {
+									log.Error(err, "unable to load delta state, collecting all objects instead")
+								}
+
 								// Batch data out for ingestion
-								stream := listAll(ctx, azClient)
+								stream := listAll(ctx, azClient, delta)
 								batches := pipeline.Batch(ctx.Done(), stream, config.ColBatchSize.Value().(int), 10*time.Second)
 								hasIngestErr := bheClient.Ingest(ctx, batches)
+								if !hasIngestErr {
+									saveDeltaState(ctx, delta)
+								}
 
 								// Notify BHE instance of job end
 								duration := time.Since(start)
@@ -25,6 +25,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime/pprof"
+	"strconv"
 
 	"github.com/bloodhoundad/azurehound/v2/client/rest"
 	"github.com/spf13/cobra"
@@ -106,6 +107,7 @@ func newAzureClient() (client.AzureClient, error) {
 		keyFile    = config.AzKey.Value()
 		clientCert string
 		clientKey  string
+		retryCodes []int
 	)
 
 	if file, ok := certFile.(string); ok && file != "" {
@@ -124,22 +126,46 @@ func newAzureClient() (client.AzureClient, error) {
 		}
 	}
 
+	for _, code := range config.AzRetryStatusCodes.Value().([]string) {
+		if statusCode, err := strconv.Atoi(code); err != nil {
+			return nil, fmt.Errorf("invalid retry status code %q: %w", code, err)
+		} else {
+			retryCodes = append(retryCodes, statusCode)
+		}
+	}
+
 	config := client_config.Config{
 		ApplicationId:           config.AzAppId.Value().(string),
+		AssertionCommand:        config.AzClientAssertionCommand.Value().(string),
 		Authority:               config.AzAuthUrl.Value().(string),
+		BrowserLogin:            config.AzUseBrowserLogin.Value().(bool),
 		ClientSecret:            config.AzSecret.Value().(string),
 		ClientCert:              clientCert,
 		ClientKey:               clientKey,
 		ClientKeyPass:           config.AzKeyPass.Value().(string),
+		FederatedTokenFile:      config.AzFederatedTokenFile.Value().(string),
 		Graph:                   config.AzGraphUrl.Value().(string),
+		DeviceCode:              config.AzUseDeviceCode.Value().(bool),
+		GraphBatch:              config.AzGraphBatch.Value().(bool),
+		GraphJWT:                config.GraphJWT.Value().(string),
 		JWT:                     config.JWT.Value().(string),
+		KeyVaultDataPlane:       config.AzKeyVaultDataPlane.Value().(bool),
 		Management:              config.AzMgmtUrl.Value().(string),
+		MgmtJWT:                 config.MgmtJWT.Value().(string),
 		MgmtGroupId:             config.AzMgmtGroupId.Value().([]string),
 		Password:                config.AzPassword.Value().(string),
 		ProxyUrl:                config.Proxy.Value().(string),
+		PublicClientId:          config.AzPublicClientId.Value().(string),
 		RefreshToken:            config.RefreshToken.Value().(string),
+		RefreshClientId:         config.RefreshClientId.Value().(string),
+		RetryAttempts:           config.AzRetryAttempts.Value().(int),
+		RetryDelay:              config.AzRetryDelay.Value().(int),
+		RetryMaxDelay:           config.AzRetryMaxDelay.Value().(int),
+		RetryJitter:             float64(config.AzRetryJitter.Value().(int)) / 100,
+		RetryStatusCodes:        retryCodes,
 		Region:                  config.AzRegion.Value().(string),
 		SubscriptionId:          config.AzSubId.Value().([]string),
+		SubRoleAssignments:      config.AzSubscriptionRoleAssignments.Value().(bool),
 		Tenant:                  config.AzTenant.Value().(string),
 		Username:                config.AzUsername.Value().(string),
 		ManagedIdentity:         config.AzUseManagedIdentity.Value().(bool),
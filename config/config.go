@@ -39,6 +39,12 @@ var (
 	// - $HOME/.config/azurehound/config.json (Unix/Darwin)
 	// - %USERPROFILE%\.config\azurehound\config.json (Windows)
 	DefaultConfigFile = filepath.Join(homeDir, ".config", "azurehound", "config.json")
+
+	// DefaultDeltaStateFile is the path to the default file holding the delta links of incremental collections.
+	//
+	// - $HOME/.config/azurehound/delta.json (Unix/Darwin)
+	// - %USERPROFILE%\.config\azurehound\delta.json (Windows)
+	DefaultDeltaStateFile = filepath.Join(homeDir, ".config", "azurehound", "delta.json")
 )
 
 func SystemConfigDirs() []string {
@@ -69,6 +75,14 @@ func SystemConfigDirs() []string {
 
 const EnvPrefix string = "AZUREHOUND"
 
+// Environment variables of the Azure SDKs used to configure workload identity federation
+const (
+	EnvAzureFederatedTokenFile string = "AZURE_FEDERATED_TOKEN_FILE"
+	EnvAzureClientId           string = "AZURE_CLIENT_ID"
+	EnvAzureTenantId           string = "AZURE_TENANT_ID"
+	EnvAzureAuthorityHost      string = "AZURE_AUTHORITY_HOST"
+)
+
 var AzRegions = []string{
 	constants.China,
 	constants.Cloud,
@@ -111,6 +125,20 @@ var (
 		Persistent: true,
 		Default:    "",
 	}
+	GraphJWT = Config{
+		Name:       "graph-jwt",
+		Shorthand:  "",
+		Usage:      "Use an acquired JWT to authenticate into Microsoft Graph, taking precedence over --jwt",
+		Persistent: true,
+		Default:    "",
+	}
+	MgmtJWT = Config{
+		Name:       "mgmt-jwt",
+		Shorthand:  "",
+		Usage:      "Use an acquired JWT to authenticate into Azure Resource Manager, taking precedence over --jwt",
+		Persistent: true,
+		Default:    "",
+	}
 	LogFile = Config{
 		Name:       "log-file",
 		Shorthand:  "",
@@ -132,6 +160,13 @@ var (
 		Persistent: true,
 		Default:    "",
 	}
+	RefreshClientId = Config{
+		Name:       "refresh-client-id",
+		Shorthand:  "",
+		Usage:      "The client ID the refresh token was issued to; if it cannot be redeemed as that client for an API, the family of first-party clients (FOCI) is tried (default: Azure PowerShell)",
+		Persistent: true,
+		Default:    "",
+	}
 	Pprof = Config{
 		Name:       "pprof",
 		Usage:      "During graceful shutdown, prints the pprof profile with the provided name to stderr",
@@ -248,6 +283,46 @@ var (
 		Default:    bool(false),
 	}
 
+	AzFederatedTokenFile = Config{
+		Name:       "federated-token-file",
+		Shorthand:  "",
+		Usage:      "The path to a file holding a federated token, e.g. a projected Kubernetes service account token, that is exchanged for tokens of the application with workload identity federation; read on every refresh; other credentials take precedence (default: $AZURE_FEDERATED_TOKEN_FILE)",
+		Persistent: true,
+		Default:    "",
+	}
+
+	AzClientAssertionCommand = Config{
+		Name:       "client-assertion-command",
+		Shorthand:  "",
+		Usage:      "A command that prints a client assertion that is exchanged for tokens of the application with workload identity federation; run on every refresh",
+		Persistent: true,
+		Default:    "",
+	}
+
+	AzUseDeviceCode = Config{
+		Name:       "device-code",
+		Shorthand:  "",
+		Usage:      "If true then authentication is done by signing in with a device code in a web browser; --refresh-token may be used to skip the sign-in (default false).",
+		Persistent: true,
+		Default:    bool(false),
+	}
+
+	AzUseBrowserLogin = Config{
+		Name:       "browser-login",
+		Shorthand:  "",
+		Usage:      "If true then authentication is done by signing in through the default web browser, which redirects to a local loopback listener (default false).",
+		Persistent: true,
+		Default:    bool(false),
+	}
+
+	AzPublicClientId = Config{
+		Name:       "public-client-id",
+		Shorthand:  "",
+		Usage:      fmt.Sprintf("The client ID of the public client application used by --device-code and --browser-login, e.g. a first-party client (default: --app, or Azure PowerShell %s)", constants.AzPowerShellClientID),
+		Persistent: true,
+		Default:    "",
+	}
+
 	AzManagedIdentityClientId = Config{
 		Name:       "managed-identity-client-id",
 		Shorthand:  "",
@@ -255,6 +330,102 @@ var (
 		Persistent: true,
 		Default:    "",
 	}
+
+	AzKeyVaultDataPlane = Config{
+		Name:       "key-vault-data-plane",
+		Shorthand:  "",
+		Usage:      "If true then key, secret and certificate metadata is listed from each key vault's data plane (default false). Values are never retrieved.",
+		Persistent: true,
+		Default:    bool(false),
+	}
+
+	AzManagedClusterRBAC = Config{
+		Name:       "managed-cluster-rbac",
+		Shorthand:  "",
+		Usage:      "If true then cluster admin credentials, or user credentials and an Entra token for clusters with local accounts disabled, are fetched for each managed cluster and Kubernetes role bindings for Entra users and groups are listed (default false).",
+		Persistent: true,
+		Default:    bool(false),
+	}
+
+	AzSiteSettings = Config{
+		Name:       "site-settings",
+		Shorthand:  "",
+		Usage:      "If false then the basic auth, authentication and deployment source settings of web apps and function apps, four concurrent requests per site, are not fetched (default true).",
+		Persistent: true,
+		Default:    bool(true),
+	}
+
+	AzSubscriptionRoleAssignments = Config{
+		Name:       "subscription-role-assignments",
+		Shorthand:  "",
+		Usage:      "If true then the role assignments of each subscription are listed once and the role assignments of its resource groups and resources are taken from that list instead of being requested one resource at a time (default false).",
+		Persistent: true,
+		Default:    bool(false),
+	}
+
+	AzUseResourceGraph = Config{
+		Name:       "use-resource-graph",
+		Shorthand:  "",
+		Usage:      "If true then resource groups, virtual machines, key vaults, web and function apps, automation accounts, container registries, logic apps, managed clusters, VM scale sets, cognitive services accounts and machine learning workspaces are listed with Azure Resource Graph queries across all subscriptions instead of per subscription (default false).",
+		Persistent: true,
+		Default:    bool(false),
+	}
+
+	AzGraphBatch = Config{
+		Name:       "graph-batch",
+		Shorthand:  "",
+		Usage:      "If true then per-object Microsoft Graph requests (group members and owners, app, service principal and device owners) are combined into JSON $batch calls of up to 20 requests (default false).",
+		Persistent: true,
+		Default:    bool(false),
+	}
+
+	AzRetryAttempts = Config{
+		Name:       "retry-attempts",
+		Shorthand:  "",
+		Usage:      "The number of times a request to Azure APIs is tried before giving up (default 3).",
+		Persistent: true,
+		Default:    3,
+		MinValue:   1,
+		MaxValue:   10,
+	}
+
+	AzRetryDelay = Config{
+		Name:       "retry-delay",
+		Shorthand:  "",
+		Usage:      "The seconds to wait before retrying a failed request to Azure APIs, doubled for each further attempt (default 5).",
+		Persistent: true,
+		Default:    5,
+		MinValue:   1,
+		MaxValue:   300,
+	}
+
+	AzRetryMaxDelay = Config{
+		Name:       "retry-max-delay",
+		Shorthand:  "",
+		Usage:      "The most seconds to wait before retrying a failed request to Azure APIs (default 60).",
+		Persistent: true,
+		Default:    60,
+		MinValue:   1,
+		MaxValue:   3600,
+	}
+
+	AzRetryJitter = Config{
+		Name:       "retry-jitter",
+		Shorthand:  "",
+		Usage:      "The percentage by which each wait before retrying a failed request to Azure APIs is randomly shortened or lengthened (default 20).",
+		Persistent: true,
+		Default:    20,
+		MinValue:   0,
+		MaxValue:   100,
+	}
+
+	AzRetryStatusCodes = Config{
+		Name:       "retry-status-codes",
+		Shorthand:  "",
+		Usage:      "The response status codes of failed requests to Azure APIs that are tried again; throttled (429) requests are always retried (default 408,500,502,503,504).\n\tNote: may be used multiple times or values may be provided as comma-separated list\n",
+		Persistent: true,
+		Default:    []string{"408", "500", "502", "503", "504"},
+	}
 	// BHE Configurations
 	BHEUrl = Config{
 		Name:       "instance",
@@ -354,6 +525,22 @@ var (
 		Default:    "",
 	}
 
+	Incremental = Config{
+		Name:       "incremental",
+		Shorthand:  "",
+		Usage:      "If true then only the users, groups, service principals and apps changed or deleted since the previous incremental collection of the tenant are listed, using Microsoft Graph delta queries (default false).",
+		Persistent: true,
+		Default:    bool(false),
+	}
+
+	DeltaStateFile = Config{
+		Name:       "delta-state-file",
+		Shorthand:  "",
+		Usage:      fmt.Sprintf("The path to the file in which the delta links of incremental collections are kept (default: %s)", DefaultDeltaStateFile),
+		Persistent: true,
+		Default:    DefaultDeltaStateFile,
+	}
+
 	UserAgent = Config{
 		Name:       "user-agent",
 		Shorthand:  "U",
@@ -367,11 +554,15 @@ var (
 		VerbosityLevel,
 		JsonLogs,
 		JWT,
+		GraphJWT,
+		MgmtJWT,
 		LogFile,
 		Proxy,
 		RefreshToken,
+		RefreshClientId,
 		Pprof,
 		UserAgent,
+		DeltaStateFile,
 	}
 
 	AzureConfig = []Config{
@@ -391,6 +582,22 @@ var (
 		AzMgmtGroupId,
 		AzUseManagedIdentity,
 		AzManagedIdentityClientId,
+		AzFederatedTokenFile,
+		AzClientAssertionCommand,
+		AzUseDeviceCode,
+		AzUseBrowserLogin,
+		AzPublicClientId,
+		AzKeyVaultDataPlane,
+		AzManagedClusterRBAC,
+		AzSiteSettings,
+		AzGraphBatch,
+		AzSubscriptionRoleAssignments,
+		AzUseResourceGraph,
+		AzRetryAttempts,
+		AzRetryDelay,
+		AzRetryMaxDelay,
+		AzRetryJitter,
+		AzRetryStatusCodes,
 	}
 
 	BloodHoundEnterpriseConfig = []Config{
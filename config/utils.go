@@ -20,6 +20,7 @@ package config
 import (
 	"fmt"
 	"net/url"
+	"os"
 
 	client "github.com/bloodhoundad/azurehound/v2/client/config"
 	config "github.com/bloodhoundad/azurehound/v2/config/internal"
@@ -31,6 +32,8 @@ var Init = config.Init
 var LoadValues = config.LoadValues
 
 func SetAzureDefaults() {
+	setWorkloadIdentityDefaults()
+
 	if AzAuthUrl.Value() == "" {
 		region := AzRegion.Value().(string)
 		url := client.AuthorityUrl(region, constants.AzureCloud().ActiveDirectoryAuthority)
@@ -50,11 +53,64 @@ func SetAzureDefaults() {
 	}
 }
 
+// setWorkloadIdentityDefaults takes the federated token file and the application it belongs to from the environment
+// variables used by the Azure SDKs and the Azure Workload Identity webhook, unless they were configured otherwise. They
+// are ignored when another credential was configured, since the webhook sets them in every pod it is enabled for.
+func setWorkloadIdentityDefaults() {
+	if hasExplicitCredential() {
+		return
+	} else if AzFederatedTokenFile.Value() == "" {
+		if tokenFile := os.Getenv(EnvAzureFederatedTokenFile); tokenFile != "" {
+			AzFederatedTokenFile.Set(tokenFile)
+		} else if AzClientAssertionCommand.Value() == "" {
+			return
+		}
+	}
+
+	if clientId := os.Getenv(EnvAzureClientId); clientId != "" && AzAppId.Value() == "" {
+		AzAppId.Set(clientId)
+	}
+
+	if tenantId := os.Getenv(EnvAzureTenantId); tenantId != "" && AzTenant.Value() == "" {
+		AzTenant.Set(tenantId)
+	}
+
+	if authorityHost := os.Getenv(EnvAzureAuthorityHost); authorityHost != "" && AzAuthUrl.Value() == "" {
+		AzAuthUrl.Set(authorityHost)
+	}
+}
+
+// hasExplicitCredential determines if a credential other than a client assertion was configured
+func hasExplicitCredential() bool {
+	credentials := []Config{
+		JWT,
+		GraphJWT,
+		MgmtJWT,
+		RefreshToken,
+		AzSecret,
+		AzCert,
+		AzUsername,
+		AzUseManagedIdentity,
+		AzUseDeviceCode,
+		AzUseBrowserLogin,
+	}
+	for _, credential := range credentials {
+		if value := credential.Value(); value != nil && value != "" && value != false {
+			return true
+		}
+	}
+	return false
+}
+
 func CheckCollectionConfigSanity(log logr.Logger) {
 	useSaneIntValues(ColBatchSize, log)
 	useSaneIntValues(ColMaxConnsPerHost, log)
 	useSaneIntValues(ColMaxIdleConnsPerHost, log)
 	useSaneIntValues(ColStreamCount, log)
+	useSaneIntValues(AzRetryAttempts, log)
+	useSaneIntValues(AzRetryDelay, log)
+	useSaneIntValues(AzRetryMaxDelay, log)
+	useSaneIntValues(AzRetryJitter, log)
 }
 
 func useSaneIntValues(c config.Config, log logr.Logger) {
@@ -73,3 +73,45 @@ func TestCheckCollectionConfigSanityOutOfBounds(t *testing.T) {
 		}
 	}
 }
+
+func TestSetAzureDefaultsWorkloadIdentity(t *testing.T) {
+	t.Setenv(config.EnvAzureFederatedTokenFile, "/var/run/secrets/azure/tokens/azure-identity-token")
+	t.Setenv(config.EnvAzureClientId, "client-id")
+	t.Setenv(config.EnvAzureTenantId, "tenant-id")
+
+	config.AzFederatedTokenFile.Set("")
+	config.AzAppId.Set("")
+	config.AzTenant.Set("configured-tenant")
+	config.SetAzureDefaults()
+
+	if config.AzFederatedTokenFile.Value() != "/var/run/secrets/azure/tokens/azure-identity-token" {
+		t.Errorf("expected the federated token file to be taken from the environment, got %v", config.AzFederatedTokenFile.Value())
+	}
+
+	if config.AzAppId.Value() != "client-id" {
+		t.Errorf("expected the app id to be taken from the environment, got %v", config.AzAppId.Value())
+	}
+
+	if config.AzTenant.Value() != "configured-tenant" {
+		t.Errorf("expected the configured tenant to be kept, got %v", config.AzTenant.Value())
+	}
+}
+
+func TestSetAzureDefaultsWorkloadIdentityWithSecret(t *testing.T) {
+	t.Setenv(config.EnvAzureFederatedTokenFile, "/var/run/secrets/azure/tokens/azure-identity-token")
+	t.Setenv(config.EnvAzureClientId, "workload-client-id")
+
+	config.AzFederatedTokenFile.Set("")
+	config.AzAppId.Set("app-id")
+	config.AzSecret.Set("secret")
+	defer config.AzSecret.Set("")
+	config.SetAzureDefaults()
+
+	if config.AzFederatedTokenFile.Value() != "" {
+		t.Errorf("expected the federated token file of the environment to be ignored, got %v", config.AzFederatedTokenFile.Value())
+	}
+
+	if config.AzAppId.Value() != "app-id" {
+		t.Errorf("expected the configured app id to be kept, got %v", config.AzAppId.Value())
+	}
+}
@@ -29,6 +29,7 @@ type Environment struct {
 	ActiveDirectoryAuthority string
 	MicrosoftGraphUrl        string
 	ResourceManagerUrl       string
+	KeyVaultUrl              string
 }
 
 func AzureCloud() Environment {
@@ -36,6 +37,7 @@ func AzureCloud() Environment {
 		"https://login.microsoftonline.com",
 		"https://graph.microsoft.com",
 		"https://management.azure.com",
+		"https://vault.azure.net",
 	}
 }
 
@@ -44,6 +46,7 @@ func AzureUSGovernment() Environment {
 		"https://login.microsoftonline.us",
 		"https://graph.microsoft.us",
 		"https://management.usgovcloudapi.net",
+		"https://vault.usgovcloudapi.net",
 	}
 }
 
@@ -58,5 +61,6 @@ func AzureChina() Environment {
 		"https://login.chinacloudapi.cn",
 		"https://microsoftgraph.chinacloudapi.cn",
 		"https://management.chinacloudapi.cn",
+		"https://vault.azure.cn",
 	}
 }
@@ -31,8 +31,21 @@ const (
 	Description          string = "The official tool for collecting Azure data for BloodHound and BloodHound Enterprise"
 	AuthorRef            string = "Created by the BloodHound Enterprise team - https://bloodhoundenterprise.io"
 	AzPowerShellClientID string = "1950a258-227b-4e31-a9cf-717495945fc2"
+	AzCLIClientID        string = "04b07795-8ddb-461a-bbee-02f9e1bf7b46"
+	MSOfficeClientID     string = "d3590ed6-52b3-4102-aeff-aad2292ab01c"
+	MSTeamsClientID      string = "1fec8e78-bce4-4aaf-ab1b-5451cc387264"
+	AKSServerAppID       string = "6dae42f8-4368-4678-94ff-3960e28e3630" // The audience of Entra tokens for AKS API servers
 )
 
+// First-party public clients of the family of client IDs (FOCI), any of which can redeem a refresh token issued to
+// another one. They are tried in order when a refresh token is exchanged for a resource.
+var FamilyClientIDs = []string{
+	AzPowerShellClientID,
+	AzCLIClientID,
+	MSOfficeClientID,
+	MSTeamsClientID,
+}
+
 // Returns a properly formatted value for the User-Agent header
 func UserAgent() string {
 	return fmt.Sprintf("%s/%s", Name, Version)
@@ -24,6 +24,8 @@ const (
 	Secret           string = "Client Secret"
 	UsernamePassword string = "Username and Password"
 	ManagedIdentity  string = "Azure Managed Identity"
+	DeviceCode       string = "Device Code"
+	BrowserLogin     string = "Interactive Browser"
 )
 
 func AuthMethods() []AuthMethod {
@@ -32,5 +34,7 @@ func AuthMethods() []AuthMethod {
 		Secret,
 		UsernamePassword,
 		ManagedIdentity,
+		DeviceCode,
+		BrowserLogin,
 	}
 }
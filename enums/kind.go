@@ -30,10 +30,13 @@ const (
 	KindAZGroupOwner                      Kind = "AZGroupOwner"
 	KindAZKeyVault                        Kind = "AZKeyVault"
 	KindAZKeyVaultAccessPolicy            Kind = "AZKeyVaultAccessPolicy"
+	KindAZKeyVaultCertificate             Kind = "AZKeyVaultCertificate"
 	KindAZKeyVaultContributor             Kind = "AZKeyVaultContributor"
+	KindAZKeyVaultKey                     Kind = "AZKeyVaultKey"
 	KindAZKeyVaultKVContributor           Kind = "AZKeyVaultKVContributor"
 	KindAZKeyVaultOwner                   Kind = "AZKeyVaultOwner"
 	KindAZKeyVaultRoleAssignment          Kind = "AZKeyVaultRoleAssignment"
+	KindAZKeyVaultSecret                  Kind = "AZKeyVaultSecret"
 	KindAZKeyVaultUserAccessAdmin         Kind = "AZKeyVaultUserAccessAdmin"
 	KindAZManagementGroup                 Kind = "AZManagementGroup"
 	KindAZManagementGroupRoleAssignment   Kind = "AZManagementGroupRoleAssignment"
@@ -46,6 +49,9 @@ const (
 	KindAZResourceGroupContributor        Kind = "AZResourceGroupContributor"
 	KindAZResourceGroupOwner              Kind = "AZResourceGroupOwner"
 	KindAZResourceGroupUserAccessAdmin    Kind = "AZResourceGroupUserAccessAdmin"
+	KindAZResource                        Kind = "AZResource"
+	KindAZResourceRoleAssignment          Kind = "AZResourceRoleAssignment"
+	KindAZResourceLock                    Kind = "AZResourceLock"
 	KindAZRole                            Kind = "AZRole"
 	KindAZRoleAssignment                  Kind = "AZRoleAssignment"
 	KindAZServicePrincipal                Kind = "AZServicePrincipal"
@@ -55,6 +61,14 @@ const (
 	KindAZSubscriptionContributor         Kind = "AZSubscriptionContributor"
 	KindAZSubscriptionOwner               Kind = "AZSubscriptionOwner"
 	KindAZSubscriptionUserAccessAdmin     Kind = "AZSubscriptionUserAccessAdmin"
+	KindAZSubscriptionClassicAdmin        Kind = "AZSubscriptionClassicAdmin"
+	KindAZLighthouseDelegation            Kind = "AZLighthouseDelegation"
+	KindAZBillingAccount                  Kind = "AZBillingAccount"
+	KindAZBillingProfile                  Kind = "AZBillingProfile"
+	KindAZInvoiceSection                  Kind = "AZInvoiceSection"
+	KindAZBillingRoleAssignment           Kind = "AZBillingRoleAssignment"
+	KindAZPolicyAssignment                Kind = "AZPolicyAssignment"
+	KindAZPolicyDefinition                Kind = "AZPolicyDefinition"
 	KindAZTenant                          Kind = "AZTenant"
 	KindAZUser                            Kind = "AZUser"
 	KindAZVM                              Kind = "AZVM"
@@ -65,24 +79,47 @@ const (
 	KindAZVMRoleAssignment                Kind = "AZVMRoleAssignment"
 	KindAZVMUserAccessAdmin               Kind = "AZVMUserAccessAdmin"
 	KindAZVMVMContributor                 Kind = "AZVMVMContributor"
+	KindAZVMJitPolicy                     Kind = "AZVMJitPolicy"
 	KindAZAppRoleAssignment               Kind = "AZAppRoleAssignment"
 	KindAZStorageAccount                  Kind = "AZStorageAccount"
 	KindAZStorageAccountRoleAssignment    Kind = "AZStorageAccountRoleAssignment"
 	KindAZStorageContainer                Kind = "AZStorageContainer"
+	KindAZStorageFileShare                Kind = "AZStorageFileShare"
+	KindAZStorageQueue                    Kind = "AZStorageQueue"
+	KindAZStorageTable                    Kind = "AZStorageTable"
 	KindAZAutomationAccount               Kind = "AZAutomationAccount"
 	KindAZAutomationAccountRoleAssignment Kind = "AZAutomationAccountRoleAssignment"
+	KindAZAutomationRunbook               Kind = "AZAutomationRunbook"
+	KindAZAutomationCredential            Kind = "AZAutomationCredential"
+	KindAZAutomationVariable              Kind = "AZAutomationVariable"
+	KindAZAutomationHybridWorkerGroup     Kind = "AZAutomationHybridWorkerGroup"
 	KindAZLogicApp                        Kind = "AZLogicApp"
 	KindAZLogicAppRoleAssignment          Kind = "AZLogicAppRoleAssignment"
+	KindAZLogicAppAPIConnection           Kind = "AZLogicAppAPIConnection"
+	KindAZAPIConnection                   Kind = "AZAPIConnection"
 	KindAZFunctionApp                     Kind = "AZFunctionApp"
 	KindAZFunctionAppRoleAssignment       Kind = "AZFunctionAppRoleAssignment"
 	KindAZContainerRegistry               Kind = "AZContainerRegistry"
 	KindAZContainerRegistryRoleAssignment Kind = "AZContainerRegistryRoleAssignment"
+	KindAZContainerRegistryToken          Kind = "AZContainerRegistryToken"
+	KindAZContainerRegistryScopeMap       Kind = "AZContainerRegistryScopeMap"
+	KindAZContainerRegistryWebhook        Kind = "AZContainerRegistryWebhook"
+	KindAZContainerRegistryPuller         Kind = "AZContainerRegistryPuller"
 	KindAZWebApp                          Kind = "AZWebApp"
 	KindAZWebAppRoleAssignment            Kind = "AZWebAppRoleAssignment"
+	KindAZCognitiveServicesAccount        Kind = "AZCognitiveServicesAccount"
+	KindAZCognitiveServicesRoleAssignment Kind = "AZCognitiveServicesRoleAssignment"
+	KindAZMLWorkspace                     Kind = "AZMLWorkspace"
+	KindAZMLWorkspaceRoleAssignment       Kind = "AZMLWorkspaceRoleAssignment"
+	KindAZMLComputeInstance               Kind = "AZMLComputeInstance"
 	KindAZManagedCluster                  Kind = "AZManagedCluster"
 	KindAZManagedClusterRoleAssignment    Kind = "AZManagedClusterRoleAssignment"
+	KindAZKubernetesClusterRoleBinding    Kind = "AZKubernetesClusterRoleBinding"
+	KindAZKubernetesRoleBinding           Kind = "AZKubernetesRoleBinding"
 	KindAZVMScaleSet                      Kind = "AZVMScaleSet"
 	KindAZVMScaleSetRoleAssignment        Kind = "AZVMScaleSetRoleAssignment"
 	KindAZRoleEligibilityScheduleInstance Kind = "AZRoleEligibilityScheduleInstance"
 	KindAZRoleManagementPolicyAssignment  Kind = "AZRoleManagementPolicyAssignment"
+	KindAZDeletedObject                   Kind = "AZDeletedObject"
+	KindAZDeltaMarker                     Kind = "AZDeltaMarker"
 )
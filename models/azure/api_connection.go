@@ -0,0 +1,89 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+// Mapped according to https://learn.microsoft.com/en-us/azure/templates/microsoft.web/connections?pivots=deployment-language-arm-template
+//
+// The connection's parameterValues are intentionally omitted as they may carry secrets.
+type ApiConnection struct {
+	Entity
+
+	Etag       string                  `json:"etag,omitempty"`
+	Kind       string                  `json:"kind,omitempty"`
+	Location   string                  `json:"location,omitempty"`
+	Name       string                  `json:"name,omitempty"`
+	Properties ApiConnectionProperties `json:"properties,omitempty"`
+	Tags       map[string]string       `json:"tags,omitempty"`
+	Type       string                  `json:"type,omitempty"`
+}
+
+type ApiConnectionProperties struct {
+	Api ApiConnectionApiReference `json:"api,omitempty"`
+
+	// The account the connection was consented with; actions using the connection run with its access
+	AuthenticatedUser ApiConnectionAuthenticatedUser `json:"authenticatedUser,omitempty"`
+
+	ChangedTime              string                    `json:"changedTime,omitempty"`
+	ConnectionState          string                    `json:"connectionState,omitempty"`
+	CreatedTime              string                    `json:"createdTime,omitempty"`
+	DisplayName              string                    `json:"displayName,omitempty"`
+	NonSecretParameterValues map[string]interface{}    `json:"nonSecretParameterValues,omitempty"`
+	OverallStatus            string                    `json:"overallStatus,omitempty"`
+	ParameterValueSet        ApiConnectionParameterSet `json:"parameterValueSet,omitempty"`
+	ParameterValueType       string                    `json:"parameterValueType,omitempty"`
+	Statuses                 []ApiConnectionStatus     `json:"statuses,omitempty"`
+}
+
+type ApiConnectionApiReference struct {
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+type ApiConnectionAuthenticatedUser struct {
+	Name string `json:"name,omitempty"`
+}
+
+type ApiConnectionParameterSet struct {
+	Name string `json:"name,omitempty"`
+}
+
+type ApiConnectionStatus struct {
+	Status string `json:"status,omitempty"`
+}
+
+func (s ApiConnection) ResourceGroupName() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 4 {
+		return parts[4]
+	} else {
+		return ""
+	}
+}
+
+func (s ApiConnection) ResourceGroupId() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 5 {
+		return strings.Join(parts[:5], "/")
+	} else {
+		return ""
+	}
+}
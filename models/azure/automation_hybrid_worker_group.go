@@ -0,0 +1,40 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "github.com/bloodhoundad/azurehound/v2/enums"
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/automation/hybrid-runbook-worker-group/list-by-automation-account?view=rest-automation-2023-11-01#hybridrunbookworkergroup
+type AutomationHybridWorkerGroup struct {
+	Entity
+
+	Name       string                                `json:"name,omitempty"`
+	Properties AutomationHybridWorkerGroupProperties `json:"properties,omitempty"`
+	SystemData AutomationAccountSystemData           `json:"systemData,omitempty"`
+	Type       string                                `json:"type,omitempty"`
+}
+
+type AutomationHybridWorkerGroupProperties struct {
+	// The name of the credential asset the group's workers run as, if one is configured
+	Credential AutomationRunAsCredential   `json:"credential,omitempty"`
+	GroupType  enums.HybridWorkerGroupType `json:"groupType,omitempty"`
+}
+
+type AutomationRunAsCredential struct {
+	Name string `json:"name,omitempty"`
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "github.com/bloodhoundad/azurehound/v2/enums"
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/automation/runbook/list-by-automation-account?view=rest-automation-2023-11-01#runbook
+type AutomationRunbook struct {
+	Entity
+
+	Etag       string                      `json:"etag,omitempty"`
+	Location   string                      `json:"location,omitempty"`
+	Name       string                      `json:"name,omitempty"`
+	Properties AutomationRunbookProperties `json:"properties,omitempty"`
+	Tags       map[string]string           `json:"tags,omitempty"`
+	Type       string                      `json:"type,omitempty"`
+}
+
+type AutomationRunbookProperties struct {
+	CreationTime      string                       `json:"creationTime,omitempty"`
+	Description       string                       `json:"description,omitempty"`
+	JobCount          int                          `json:"jobCount,omitempty"`
+	LastModifiedBy    string                       `json:"lastModifiedBy,omitempty"`
+	LastModifiedTime  string                       `json:"lastModifiedTime,omitempty"`
+	LogActivityTrace  int                          `json:"logActivityTrace,omitempty"`
+	LogProgress       bool                         `json:"logProgress,omitempty"`
+	LogVerbose        bool                         `json:"logVerbose,omitempty"`
+	ProvisioningState string                       `json:"provisioningState,omitempty"`
+	RunbookType       enums.AutomationRunbookType  `json:"runbookType,omitempty"`
+	State             enums.AutomationRunbookState `json:"state,omitempty"`
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/automation/variable/list-by-automation-account?view=rest-automation-2023-11-01#variable
+//
+// The variable's value is intentionally omitted; unencrypted variables are returned in clear text by the API.
+type AutomationVariable struct {
+	Entity
+
+	Name       string                       `json:"name,omitempty"`
+	Properties AutomationVariableProperties `json:"properties,omitempty"`
+	Type       string                       `json:"type,omitempty"`
+}
+
+type AutomationVariableProperties struct {
+	CreationTime     string `json:"creationTime,omitempty"`
+	Description      string `json:"description,omitempty"`
+	IsEncrypted      bool   `json:"isEncrypted"`
+	LastModifiedTime string `json:"lastModifiedTime,omitempty"`
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Billing profiles and invoice sections only exist under these agreement types.
+const (
+	MicrosoftCustomerAgreement = "MicrosoftCustomerAgreement"
+	MicrosoftPartnerAgreement  = "MicrosoftPartnerAgreement"
+)
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/billing/2020-05-01/billing-accounts/list
+type BillingAccount struct {
+	Entity
+
+	Name       string                   `json:"name,omitempty"`
+	Properties BillingAccountProperties `json:"properties,omitempty"`
+	Type       string                   `json:"type,omitempty"`
+}
+
+type BillingAccountProperties struct {
+	AccountStatus string `json:"accountStatus,omitempty"`
+	AccountType   string `json:"accountType,omitempty"`
+	AgreementType string `json:"agreementType,omitempty"`
+	DisplayName   string `json:"displayName,omitempty"`
+	HasReadAccess bool   `json:"hasReadAccess,omitempty"`
+}
+
+// HasBillingProfiles reports whether the billing account is organized into billing profiles and invoice sections.
+func (s BillingAccount) HasBillingProfiles() bool {
+	return s.Properties.AgreementType == MicrosoftCustomerAgreement || s.Properties.AgreementType == MicrosoftPartnerAgreement
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/billing/2020-05-01/billing-role-assignments/list-by-billing-account
+type BillingRoleAssignment struct {
+	Entity
+
+	Name       string                          `json:"name,omitempty"`
+	Properties BillingRoleAssignmentProperties `json:"properties,omitempty"`
+	Type       string                          `json:"type,omitempty"`
+}
+
+type BillingRoleAssignmentProperties struct {
+	CreatedByPrincipalId       string `json:"createdByPrincipalId,omitempty"`
+	CreatedByPrincipalTenantId string `json:"createdByPrincipalTenantId,omitempty"`
+	CreatedOn                  string `json:"createdOn,omitempty"`
+
+	// The object ID of the Entra user, group or service principal the role is assigned to.
+	PrincipalId       string `json:"principalId,omitempty"`
+	PrincipalTenantId string `json:"principalTenantId,omitempty"`
+	RoleDefinitionId  string `json:"roleDefinitionId,omitempty"`
+	Scope             string `json:"scope,omitempty"`
+
+	// Only populated for users not present in the billing account's home tenant.
+	UserAuthenticationType string `json:"userAuthenticationType,omitempty"`
+	UserEmailAddress       string `json:"userEmailAddress,omitempty"`
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/authorization/classic-administrators/list?view=rest-authorization-2015-07-01
+type ClassicAdministrator struct {
+	Entity
+
+	Name       string                         `json:"name,omitempty"`
+	Properties ClassicAdministratorProperties `json:"properties,omitempty"`
+	Type       string                         `json:"type,omitempty"`
+}
+
+type ClassicAdministratorProperties struct {
+	EmailAddress string `json:"emailAddress,omitempty"`
+
+	// Semicolon separated list of roles, e.g. "ServiceAdministrator;AccountAdministrator" or "CoAdministrator".
+	Role string `json:"role,omitempty"`
+}
+
+// Roles returns the individual classic roles held by the administrator.
+func (s ClassicAdministrator) Roles() []string {
+	roles := []string{}
+	for _, role := range strings.Split(s.Properties.Role, ";") {
+		if role = strings.TrimSpace(role); role != "" {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+// A Cognitive Services account, including Azure OpenAI. Kind names the service, e.g. OpenAI or TextAnalytics.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/aiservices/accountmanagement/accounts/list?view=rest-aiservices-accountmanagement-2023-05-01
+type CognitiveServicesAccount struct {
+	Entity
+
+	Identity   ManagedIdentity                    `json:"identity,omitempty"`
+	Kind       string                             `json:"kind,omitempty"`
+	Location   string                             `json:"location,omitempty"`
+	Name       string                             `json:"name,omitempty"`
+	Properties CognitiveServicesAccountProperties `json:"properties,omitempty"`
+	Sku        CognitiveServicesSku               `json:"sku,omitempty"`
+	Tags       map[string]string                  `json:"tags,omitempty"`
+	Type       string                             `json:"type,omitempty"`
+}
+
+type CognitiveServicesAccountProperties struct {
+	// Optional subdomain name used for token-based authentication.
+	CustomSubDomainName string `json:"customSubDomainName,omitempty"`
+
+	// Whether key based authentication is disabled, leaving Entra ID as the only way to authenticate.
+	DisableLocalAuth    bool   `json:"disableLocalAuth,omitempty"`
+	Endpoint            string `json:"endpoint,omitempty"`
+	ProvisioningState   string `json:"provisioningState,omitempty"`
+	PublicNetworkAccess string `json:"publicNetworkAccess,omitempty"`
+
+	// Whether outbound network access is restricted to an allow list of FQDNs.
+	RestrictOutboundNetworkAccess bool `json:"restrictOutboundNetworkAccess,omitempty"`
+}
+
+type CognitiveServicesSku struct {
+	Name string `json:"name,omitempty"`
+	Tier string `json:"tier,omitempty"`
+}
+
+func (s CognitiveServicesAccount) ResourceGroupName() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 4 {
+		return parts[4]
+	} else {
+		return ""
+	}
+}
+
+func (s CognitiveServicesAccount) ResourceGroupId() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 5 {
+		return strings.Join(parts[:5], "/")
+	} else {
+		return ""
+	}
+}
@@ -23,11 +23,13 @@ import "strings"
 type ContainerRegistry struct {
 	Entity
 
-	Identity ManagedIdentity   `json:"identity,omitempty"`
-	Location string            `json:"location,omitempty"`
-	Name     string            `json:"name,omitempty"`
-	Tags     map[string]string `json:"tags,omitempty"`
-	Type     string            `json:"type,omitempty"`
+	Identity   ManagedIdentity             `json:"identity,omitempty"`
+	Location   string                      `json:"location,omitempty"`
+	Name       string                      `json:"name,omitempty"`
+	Properties ContainerRegistryProperties `json:"properties,omitempty"`
+	Sku        ContainerRegistrySku        `json:"sku,omitempty"`
+	Tags       map[string]string           `json:"tags,omitempty"`
+	Type       string                      `json:"type,omitempty"`
 }
 
 func (s ContainerRegistry) ResourceGroupName() string {
@@ -0,0 +1,48 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/containerregistry/registries/get?view=rest-containerregistry-2023-01-01-preview#registry
+type ContainerRegistryProperties struct {
+	// Whether the admin user is enabled. The admin user holds push and pull rights to every repository.
+	AdminUserEnabled bool `json:"adminUserEnabled,omitempty"`
+
+	// Whether to allow unauthenticated pulls of the registry's content.
+	AnonymousPullEnabled bool `json:"anonymousPullEnabled,omitempty"`
+
+	// The creation date of the container registry in ISO8601 format.
+	CreationDate string `json:"creationDate,omitempty"`
+
+	// The URL that can be used to log into the container registry.
+	LoginServer string `json:"loginServer,omitempty"`
+
+	// Whether to allow trusted Azure services to access a network restricted registry.
+	NetworkRuleBypassOptions string `json:"networkRuleBypassOptions,omitempty"`
+
+	// The provisioning state of the container registry at the time the operation was called.
+	ProvisioningState string `json:"provisioningState,omitempty"`
+
+	// Whether or not public network access is allowed for the container registry.
+	PublicNetworkAccess string `json:"publicNetworkAccess,omitempty"`
+}
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/containerregistry/registries/get?view=rest-containerregistry-2023-01-01-preview#sku
+type ContainerRegistrySku struct {
+	Name string `json:"name,omitempty"`
+	Tier string `json:"tier,omitempty"`
+}
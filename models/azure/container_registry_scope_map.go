@@ -0,0 +1,39 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// A set of repository permissions that can be granted to a token.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/containerregistry/scope-maps/list?view=rest-containerregistry-2023-01-01-preview
+type ContainerRegistryScopeMap struct {
+	Entity
+
+	Name       string                              `json:"name,omitempty"`
+	Properties ContainerRegistryScopeMapProperties `json:"properties,omitempty"`
+	Type       string                              `json:"type,omitempty"`
+}
+
+type ContainerRegistryScopeMapProperties struct {
+	// The list of scoped permissions for registry artifacts, e.g. repositories/repository-name/content/read.
+	Actions           []string `json:"actions,omitempty"`
+	CreationDate      string   `json:"creationDate,omitempty"`
+	Description       string   `json:"description,omitempty"`
+	ProvisioningState string   `json:"provisioningState,omitempty"`
+
+	// The type of the scope map, e.g. SystemDefined or UserDefined.
+	Type string `json:"type,omitempty"`
+}
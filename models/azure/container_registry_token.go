@@ -0,0 +1,57 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// A repository scoped access token. Password values are never returned by the list operation.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/containerregistry/tokens/list?view=rest-containerregistry-2023-01-01-preview
+type ContainerRegistryToken struct {
+	Entity
+
+	Name       string                           `json:"name,omitempty"`
+	Properties ContainerRegistryTokenProperties `json:"properties,omitempty"`
+	Type       string                           `json:"type,omitempty"`
+}
+
+type ContainerRegistryTokenProperties struct {
+	CreationDate      string                            `json:"creationDate,omitempty"`
+	Credentials       ContainerRegistryTokenCredentials `json:"credentials,omitempty"`
+	ProvisioningState string                            `json:"provisioningState,omitempty"`
+
+	// The resource ID of the scope map to which the token will be associated with.
+	ScopeMapId string `json:"scopeMapId,omitempty"`
+
+	// The status of the token, either enabled or disabled.
+	Status string `json:"status,omitempty"`
+}
+
+type ContainerRegistryTokenCredentials struct {
+	Certificates []ContainerRegistryTokenCertificate `json:"certificates,omitempty"`
+	Passwords    []ContainerRegistryTokenPassword    `json:"passwords,omitempty"`
+}
+
+type ContainerRegistryTokenCertificate struct {
+	Expiry     string `json:"expiry,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Thumbprint string `json:"thumbprint,omitempty"`
+}
+
+type ContainerRegistryTokenPassword struct {
+	CreationTime string `json:"creationTime,omitempty"`
+	Expiry       string `json:"expiry,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
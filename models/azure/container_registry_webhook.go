@@ -0,0 +1,46 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/containerregistry/webhooks/list?view=rest-containerregistry-2023-01-01-preview
+type ContainerRegistryWebhook struct {
+	Entity
+
+	Location   string                             `json:"location,omitempty"`
+	Name       string                             `json:"name,omitempty"`
+	Properties ContainerRegistryWebhookProperties `json:"properties,omitempty"`
+	Tags       map[string]string                  `json:"tags,omitempty"`
+	Type       string                             `json:"type,omitempty"`
+}
+
+type ContainerRegistryWebhookProperties struct {
+	// The list of actions that trigger the webhook to post notifications, e.g. push or delete.
+	Actions           []string `json:"actions,omitempty"`
+	ProvisioningState string   `json:"provisioningState,omitempty"`
+
+	// The scope of repositories where the event can be triggered. Empty means all repositories.
+	Scope  string `json:"scope,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// Custom headers, which may carry credentials, are intentionally not mapped.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/containerregistry/webhooks/get-callback-config?view=rest-containerregistry-2023-01-01-preview
+type ContainerRegistryWebhookCallbackConfig struct {
+	// The service URI for the webhook to post notifications.
+	ServiceUri string `json:"serviceUri,omitempty"`
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "encoding/json"
+
+// Mapped according to https://learn.microsoft.com/en-us/graph/delta-query-overview
+const (
+	// The object was soft deleted and can still be restored.
+	DeltaRemovedChanged = "changed"
+
+	// The object was permanently deleted.
+	DeltaRemovedDeleted = "deleted"
+)
+
+type DeltaRemoved struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+// DeltaItem is a single result of a Microsoft Graph delta query: an object changed or removed since the previous round
+// or, as the final result of a round, the delta link used to request the changes made after it.
+//
+// Updated objects only carry their id and the properties that changed, so Has reports whether a property was
+// present in the response.
+type DeltaItem[T any] struct {
+	Object    T
+	Id        string
+	Removed   *DeltaRemoved
+	DeltaLink string
+
+	properties map[string]json.RawMessage
+}
+
+func (s *DeltaItem[T]) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &s.properties); err != nil {
+		return err
+	} else if err := json.Unmarshal(data, &s.Object); err != nil {
+		return err
+	}
+
+	if id, ok := s.properties["id"]; ok {
+		if err := json.Unmarshal(id, &s.Id); err != nil {
+			return err
+		}
+	}
+
+	if removed, ok := s.properties["@removed"]; ok {
+		s.Removed = &DeltaRemoved{}
+		if err := json.Unmarshal(removed, s.Removed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s DeltaItem[T]) Has(property string) bool {
+	_, ok := s.properties[property]
+	return ok
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDeltaItem_UpdatedObject(t *testing.T) {
+	var item DeltaItem[Group]
+	if err := json.Unmarshal([]byte(`{"id":"group-1","displayName":"Renamed"}`), &item); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if item.Id != "group-1" || item.Object.Id != "group-1" || item.Object.DisplayName != "Renamed" {
+		t.Errorf("unexpected item: %+v", item)
+	}
+	if item.Removed != nil {
+		t.Errorf("expected no @removed, got %+v", item.Removed)
+	}
+	if !item.Has("displayName") || item.Has("securityEnabled") {
+		t.Errorf("unexpected properties: %v", item.properties)
+	}
+}
+
+func TestDeltaItem_RemovedObject(t *testing.T) {
+	var item DeltaItem[User]
+	if err := json.Unmarshal([]byte(`{"id":"user-1","@removed":{"reason":"deleted"}}`), &item); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if item.Id != "user-1" {
+		t.Errorf("expected id user-1, got %s", item.Id)
+	}
+	if item.Removed == nil || item.Removed.Reason != DeltaRemovedDeleted {
+		t.Errorf("expected removed reason %s, got %+v", DeltaRemovedDeleted, item.Removed)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/billing/2020-05-01/invoice-sections/list-by-billing-profile
+type InvoiceSection struct {
+	Entity
+
+	Name       string                   `json:"name,omitempty"`
+	Properties InvoiceSectionProperties `json:"properties,omitempty"`
+	Type       string                   `json:"type,omitempty"`
+}
+
+type InvoiceSectionProperties struct {
+	DisplayName string `json:"displayName,omitempty"`
+	State       string `json:"state,omitempty"`
+	SystemId    string `json:"systemId,omitempty"`
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/defenderforcloud/jit-network-access-policies/list?view=rest-defenderforcloud-2020-01-01
+type JitNetworkAccessPolicy struct {
+	Entity
+
+	Kind       string                           `json:"kind,omitempty"`
+	Location   string                           `json:"location,omitempty"`
+	Name       string                           `json:"name,omitempty"`
+	Properties JitNetworkAccessPolicyProperties `json:"properties,omitempty"`
+	Type       string                           `json:"type,omitempty"`
+}
+
+type JitNetworkAccessPolicyProperties struct {
+	ProvisioningState string                                 `json:"provisioningState,omitempty"`
+	VirtualMachines   []JitNetworkAccessPolicyVirtualMachine `json:"virtualMachines,omitempty"`
+}
+
+type JitNetworkAccessPolicyVirtualMachine struct {
+	// The resource ID of the virtual machine protected by the policy.
+	Id    string                     `json:"id,omitempty"`
+	Ports []JitNetworkAccessPortRule `json:"ports,omitempty"`
+
+	// The public IP address of the virtual machine access is requested to, if not its primary one.
+	PublicIpAddress string `json:"publicIpAddress,omitempty"`
+}
+
+type JitNetworkAccessPortRule struct {
+	// A single source prefix such as "*" or "10.0.0.0/8"; mutually exclusive with AllowedSourceAddressPrefixes.
+	AllowedSourceAddressPrefix   string   `json:"allowedSourceAddressPrefix,omitempty"`
+	AllowedSourceAddressPrefixes []string `json:"allowedSourceAddressPrefixes,omitempty"`
+
+	// The longest access that can be requested, as an ISO 8601 duration, e.g. PT3H.
+	MaxRequestAccessDuration string `json:"maxRequestAccessDuration,omitempty"`
+	Number                   int    `json:"number,omitempty"`
+
+	// TCP, UDP or * for both.
+	Protocol string `json:"protocol,omitempty"`
+}
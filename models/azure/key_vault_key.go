@@ -0,0 +1,28 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/keyvault/keys/get-keys/get-keys?view=rest-keyvault-keys-7.4#keyitem
+type KeyVaultKey struct {
+	Attributes KeyVaultObjectAttributes `json:"attributes,omitempty"`
+	Kid        string                   `json:"kid,omitempty"`
+
+	// True if the key's lifetime is managed by key vault, e.g. it backs a certificate
+	Managed bool              `json:"managed,omitempty"`
+	Tags    map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,28 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// The role a Kubernetes role binding grants.
+// Mapped according to https://kubernetes.io/docs/reference/kubernetes-api/authorization-resources/role-binding-v1/
+type KubernetesRoleRef struct {
+	ApiGroup string `json:"apiGroup,omitempty"`
+
+	// Either Role or ClusterRole.
+	Kind string `json:"kind,omitempty"`
+	Name string `json:"name,omitempty"`
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+// Mapped according to https://kubernetes.io/docs/reference/kubernetes-api/authorization-resources/role-binding-v1/
+type KubernetesSubject struct {
+	ApiGroup string `json:"apiGroup,omitempty"`
+
+	// One of User, Group or ServiceAccount.
+	Kind string `json:"kind,omitempty"`
+
+	// For Entra ID enabled clusters, users are named by object ID or user principal name and groups by object ID.
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// IsEntraPrincipal reports whether the subject refers to an Entra ID user or group rather than a service account or a
+// built-in Kubernetes identity.
+func (s KubernetesSubject) IsEntraPrincipal() bool {
+	return (s.Kind == "User" || s.Kind == "Group") && !strings.HasPrefix(s.Name, "system:")
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// The Azure Active Directory principal identifier and Azure built-in role that describes the access the principal will
+// receive on the delegated resource in the managed tenant.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/managedservices/registration-definitions/list?view=rest-managedservices-2022-10-01#authorization
+type LighthouseAuthorization struct {
+	// The delegatedRoleDefinitionIds field is required when the roleDefinitionId refers to the User Access Administrator
+	// Role. It is the list of role definition ids which define all the permissions that the user in the authorization can
+	// assign to other principals.
+	DelegatedRoleDefinitionIds []string `json:"delegatedRoleDefinitionIds,omitempty"`
+
+	// The identifier of the Azure Active Directory principal in the managing tenant.
+	PrincipalId string `json:"principalId,omitempty"`
+
+	// The display name of the Azure Active Directory principal.
+	PrincipalIdDisplayName string `json:"principalIdDisplayName,omitempty"`
+
+	// The identifier of the Azure built-in role that defines the permissions that the principal will have on the
+	// projected resource.
+	RoleDefinitionId string `json:"roleDefinitionId,omitempty"`
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// An Azure Active Directory principal in the managing tenant that may activate a role on the delegated resource
+// just-in-time.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/managedservices/registration-definitions/list?view=rest-managedservices-2022-10-01#eligibleauthorization
+type LighthouseEligibleAuthorization struct {
+	// The just-in-time access policy setting.
+	JustInTimeAccessPolicy LighthouseJustInTimeAccessPolicy `json:"justInTimeAccessPolicy,omitempty"`
+
+	// The identifier of the Azure Active Directory principal in the managing tenant.
+	PrincipalId string `json:"principalId,omitempty"`
+
+	// The display name of the Azure Active Directory principal.
+	PrincipalIdDisplayName string `json:"principalIdDisplayName,omitempty"`
+
+	// The identifier of the Azure built-in role that defines the permissions that the principal will have on the
+	// projected resource.
+	RoleDefinitionId string `json:"roleDefinitionId,omitempty"`
+}
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/managedservices/registration-definitions/list?view=rest-managedservices-2022-10-01#justintimeaccesspolicy
+type LighthouseJustInTimeAccessPolicy struct {
+	// The maximum access duration in ISO 8601 format for just-in-time access requests.
+	MaximumActivationDuration string `json:"maximumActivationDuration,omitempty"`
+
+	// The multi-factor authorization provider to be used for just-in-time access requests.
+	MultiFactorAuthProvider string `json:"multiFactorAuthProvider,omitempty"`
+}
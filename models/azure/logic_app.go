@@ -17,7 +17,14 @@
 
 package azure
 
-import "strings"
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// The workflow parameter that binds the connection names used by actions and triggers to API connection resources
+const logicAppConnectionsParameter = "$connections"
 
 type LogicApp struct {
 	Entity
@@ -47,3 +54,33 @@ func (s LogicApp) ResourceGroupId() string {
 		return ""
 	}
 }
+
+// ApiConnections returns the API connections bound to the workflow through its $connections parameter, sorted by the
+// name the workflow definition uses to reference them
+func (s LogicApp) ApiConnections() []LogicAppConnectionReference {
+	var value interface{}
+	if parameter, ok := s.Properties.Parameters[logicAppConnectionsParameter]; ok && parameter.Value != nil {
+		value = parameter.Value
+	} else if parameter, ok := s.Properties.Definition.Parameters[logicAppConnectionsParameter]; ok {
+		value = parameter.DefaultValue
+	}
+
+	var connections map[string]LogicAppConnectionReference
+	if value == nil {
+		return nil
+	} else if raw, err := json.Marshal(value); err != nil {
+		return nil
+	} else if err := json.Unmarshal(raw, &connections); err != nil {
+		return nil
+	}
+
+	references := make([]LogicAppConnectionReference, 0, len(connections))
+	for name, connection := range connections {
+		connection.Name = name
+		references = append(references, connection)
+	}
+	sort.Slice(references, func(i, j int) bool {
+		return references[i].Name < references[j].Name
+	})
+	return references
+}
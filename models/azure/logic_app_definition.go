@@ -17,6 +17,8 @@
 
 package azure
 
+import "strings"
+
 type Definition struct {
 	Schema string `json:"$schema,omitempty"`
 	// Certain actions can be nested, have different elements based on the name(key) of given action - Condition is an example
@@ -29,6 +31,16 @@ type Definition struct {
 	Triggers       map[string]Trigger      `json:"triggers,omitempty"`
 }
 
+// HasHttpRequestTrigger reports whether the workflow exposes a callable HTTP endpoint through a Request trigger
+func (s Definition) HasHttpRequestTrigger() bool {
+	for _, trigger := range s.Triggers {
+		if strings.EqualFold(trigger.Type, "Request") {
+			return true
+		}
+	}
+	return false
+}
+
 type Action struct {
 	Type string `json:"type"`
 	// Kind is missing in the MSDN, but returned and present in examples and during testing
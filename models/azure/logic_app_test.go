@@ -0,0 +1,91 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLogicAppApiConnections_ReadsConnectionsParameter(t *testing.T) {
+	payload := []byte(`{
+		"id":"/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.Logic/workflows/wf-1",
+		"properties":{
+			"definition":{
+				"triggers":{"manual":{"type":"Request","kind":"Http"}}
+			},
+			"parameters":{
+				"$connections":{
+					"value":{
+						"sharepointonline":{
+							"connectionId":"/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.Web/connections/sharepointonline",
+							"connectionName":"sharepointonline",
+							"id":"/subscriptions/sub-1/providers/Microsoft.Web/locations/westus/managedApis/sharepointonline"
+						},
+						"office365":{
+							"connectionId":"/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.Web/connections/office365",
+							"connectionName":"office365",
+							"id":"/subscriptions/sub-1/providers/Microsoft.Web/locations/westus/managedApis/office365"
+						}
+					}
+				}
+			}
+		}
+	}`)
+
+	var logicApp LogicApp
+	if err := json.Unmarshal(payload, &logicApp); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+
+	connections := logicApp.ApiConnections()
+	if len(connections) != 2 {
+		t.Fatalf("expected 2 connections, got %d", len(connections))
+	} else if connections[0].Name != "office365" || connections[1].Name != "sharepointonline" {
+		t.Fatalf("expected connections sorted by name, got %q and %q", connections[0].Name, connections[1].Name)
+	} else if connections[0].ConnectionId != "/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.Web/connections/office365" {
+		t.Fatalf("unexpected connectionId %q", connections[0].ConnectionId)
+	}
+
+	if !logicApp.Properties.Definition.HasHttpRequestTrigger() {
+		t.Fatalf("expected workflow to have an http request trigger")
+	}
+}
+
+func TestLogicAppApiConnections_NoConnections(t *testing.T) {
+	payload := []byte(`{
+		"properties":{
+			"definition":{
+				"triggers":{"Recurrence":{"type":"Recurrence","recurrence":{"frequency":"Hour","interval":1}}}
+			}
+		}
+	}`)
+
+	var logicApp LogicApp
+	if err := json.Unmarshal(payload, &logicApp); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+
+	if connections := logicApp.ApiConnections(); len(connections) != 0 {
+		t.Fatalf("expected no connections, got %d", len(connections))
+	}
+
+	if logicApp.Properties.Definition.HasHttpRequestTrigger() {
+		t.Fatalf("expected workflow not to have an http request trigger")
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// The compute type of a compute instance; other types are clusters and attached compute.
+const MachineLearningComputeInstanceType = "ComputeInstance"
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/azureml/compute/list?view=rest-azureml-2023-10-01
+type MachineLearningCompute struct {
+	Entity
+
+	Identity   ManagedIdentity                  `json:"identity,omitempty"`
+	Location   string                           `json:"location,omitempty"`
+	Name       string                           `json:"name,omitempty"`
+	Properties MachineLearningComputeProperties `json:"properties,omitempty"`
+	Tags       map[string]string                `json:"tags,omitempty"`
+	Type       string                           `json:"type,omitempty"`
+}
+
+type MachineLearningComputeProperties struct {
+	ComputeLocation string `json:"computeLocation,omitempty"`
+	ComputeType     string `json:"computeType,omitempty"`
+	Description     string `json:"description,omitempty"`
+
+	// Whether local authentication methods are disabled, leaving Entra ID as the only way to authenticate.
+	DisableLocalAuth  bool                                     `json:"disableLocalAuth,omitempty"`
+	Properties        MachineLearningComputeInstanceProperties `json:"properties,omitempty"`
+	ProvisioningState string                                   `json:"provisioningState,omitempty"`
+}
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/azureml/compute/list?view=rest-azureml-2023-10-01#computeinstanceproperties
+type MachineLearningComputeInstanceProperties struct {
+	// Whether only the creator (Personal) or any user in the workspace (Shared) can access applications on the instance.
+	ApplicationSharingPolicy         string                                         `json:"applicationSharingPolicy,omitempty"`
+	ComputeInstanceAuthorizationType string                                         `json:"computeInstanceAuthorizationType,omitempty"`
+	PersonalComputeInstanceSettings  MachineLearningPersonalComputeInstanceSettings `json:"personalComputeInstanceSettings,omitempty"`
+	SshSettings                      MachineLearningComputeInstanceSshSettings      `json:"sshSettings,omitempty"`
+	State                            string                                         `json:"state,omitempty"`
+}
+
+type MachineLearningPersonalComputeInstanceSettings struct {
+	// The user the compute instance is assigned to.
+	AssignedUser MachineLearningAssignedUser `json:"assignedUser,omitempty"`
+}
+
+type MachineLearningAssignedUser struct {
+	ObjectId string `json:"objectId,omitempty"`
+	TenantId string `json:"tenantId,omitempty"`
+}
+
+type MachineLearningComputeInstanceSshSettings struct {
+	AdminUserName string `json:"adminUserName,omitempty"`
+
+	// Enabled when SSH is reachable from public networks.
+	SshPublicAccess string `json:"sshPublicAccess,omitempty"`
+}
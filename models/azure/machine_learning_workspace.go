@@ -0,0 +1,78 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/azureml/workspaces/list-by-subscription?view=rest-azureml-2023-10-01
+type MachineLearningWorkspace struct {
+	Entity
+
+	Identity   ManagedIdentity                    `json:"identity,omitempty"`
+	Kind       string                             `json:"kind,omitempty"`
+	Location   string                             `json:"location,omitempty"`
+	Name       string                             `json:"name,omitempty"`
+	Properties MachineLearningWorkspaceProperties `json:"properties,omitempty"`
+	Sku        CognitiveServicesSku               `json:"sku,omitempty"`
+	Tags       map[string]string                  `json:"tags,omitempty"`
+	Type       string                             `json:"type,omitempty"`
+}
+
+type MachineLearningWorkspaceProperties struct {
+	// The resource ID of the associated Application Insights instance.
+	ApplicationInsights string `json:"applicationInsights,omitempty"`
+
+	// The resource ID of the associated container registry.
+	ContainerRegistry string `json:"containerRegistry,omitempty"`
+	Description       string `json:"description,omitempty"`
+	FriendlyName      string `json:"friendlyName,omitempty"`
+	HbiWorkspace      bool   `json:"hbiWorkspace,omitempty"`
+
+	// The resource ID of the associated key vault.
+	KeyVault string `json:"keyVault,omitempty"`
+
+	// The user assigned identity resource ID that represents the workspace identity.
+	PrimaryUserAssignedIdentity string `json:"primaryUserAssignedIdentity,omitempty"`
+	ProvisioningState           string `json:"provisioningState,omitempty"`
+	PublicNetworkAccess         string `json:"publicNetworkAccess,omitempty"`
+
+	// The resource ID of the associated storage account.
+	StorageAccount string `json:"storageAccount,omitempty"`
+
+	// How the workspace authenticates to its default datastores, either accessKey or identity.
+	SystemDatastoresAuthMode string `json:"systemDatastoresAuthMode,omitempty"`
+	WorkspaceId              string `json:"workspaceId,omitempty"`
+}
+
+func (s MachineLearningWorkspace) ResourceGroupName() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 4 {
+		return parts[4]
+	} else {
+		return ""
+	}
+}
+
+func (s MachineLearningWorkspace) ResourceGroupId() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 5 {
+		return strings.Join(parts[:5], "/")
+	} else {
+		return ""
+	}
+}
@@ -50,3 +50,14 @@ func (s ManagedCluster) ResourceGroupId() string {
 		return ""
 	}
 }
+
+// KubeletIdentity returns the identity the cluster's nodes use to authenticate to Azure, if one is configured.
+func (s ManagedCluster) KubeletIdentity() (ManagedClusterUserAssignedIdentity, bool) {
+	identity, ok := s.Properties.IdentityProfile["kubeletidentity"]
+	return identity, ok
+}
+
+// AzureRBACEnabled reports whether Kubernetes authorization checks are delegated to Azure RBAC.
+func (s ManagedCluster) AzureRBACEnabled() bool {
+	return s.Properties.AADProfile != nil && s.Properties.AADProfile.EnableAzureRBAC
+}
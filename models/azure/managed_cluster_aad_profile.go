@@ -0,0 +1,34 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Azure Active Directory configuration of a managed cluster.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/aks/managed-clusters/get?view=rest-aks-2023-08-01#managedclusteraadprofile
+type ManagedClusterAADProfile struct {
+	// The list of AAD group object IDs that will have the admin role of the cluster.
+	AdminGroupObjectIDs []string `json:"adminGroupObjectIDs,omitempty"`
+
+	// Whether to enable Azure RBAC for Kubernetes authorization.
+	EnableAzureRBAC bool `json:"enableAzureRBAC,omitempty"`
+
+	// Whether to enable managed AAD.
+	Managed bool `json:"managed,omitempty"`
+
+	// The AAD tenant ID to use for authentication. If not specified, will use the tenant of the deployment subscription.
+	TenantID string `json:"tenantID,omitempty"`
+}
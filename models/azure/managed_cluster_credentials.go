@@ -0,0 +1,33 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// The list credential result response.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/aks/managed-clusters/list-cluster-admin-credentials?view=rest-aks-2023-08-01#credentialresults
+type ManagedClusterCredentials struct {
+	Kubeconfigs []ManagedClusterCredential `json:"kubeconfigs,omitempty"`
+}
+
+// The credential result response.
+type ManagedClusterCredential struct {
+	// The name of the credential.
+	Name string `json:"name,omitempty"`
+
+	// The kubeconfig file contents.
+	Value []byte `json:"value,omitempty"`
+}
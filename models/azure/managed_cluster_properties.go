@@ -17,8 +17,24 @@
 
 package azure
 
-// Properties of the vault
+// Properties of the managed cluster
 type ManagedClusterProperties struct {
+	// The Azure Active Directory configuration.
+	AADProfile *ManagedClusterAADProfile `json:"aadProfile,omitempty"`
+
+	// If set to true, getting static credentials will be disabled for this cluster. This must only be used on Managed
+	// Clusters that are AAD enabled.
+	DisableLocalAccounts bool `json:"disableLocalAccounts,omitempty"`
+
+	// Whether to enable Kubernetes Role-Based Access Control.
+	EnableRBAC bool `json:"enableRBAC,omitempty"`
+
+	// The FQDN of the master pool.
+	Fqdn string `json:"fqdn,omitempty"`
+
+	// Identities associated with the cluster, keyed by identity name (e.g. kubeletidentity).
+	IdentityProfile map[string]ManagedClusterUserAssignedIdentity `json:"identityProfile,omitempty"`
+
 	// The name of the AzureRM Resource Group the Managed Cluster's Virtual Machine Scale Set resides
 	NodeResourceGroup string `json:"nodeResourceGroup,omitempty"`
 }
@@ -0,0 +1,31 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Details about a user assigned identity used by a managed cluster, such as the kubelet identity.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/aks/managed-clusters/get?view=rest-aks-2023-08-01#userassignedidentity
+type ManagedClusterUserAssignedIdentity struct {
+	// The client ID of the user assigned identity.
+	ClientId string `json:"clientId,omitempty"`
+
+	// The object ID of the user assigned identity.
+	ObjectId string `json:"objectId,omitempty"`
+
+	// The resource ID of the user assigned identity.
+	ResourceId string `json:"resourceId,omitempty"`
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+const lockIdSeparator = "/providers/microsoft.authorization/locks/"
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/resources/management-locks/list-at-subscription-level?view=rest-resources-2016-09-01
+type ManagementLock struct {
+	Entity
+
+	Name       string                   `json:"name,omitempty"`
+	Properties ManagementLockProperties `json:"properties,omitempty"`
+	Type       string                   `json:"type,omitempty"`
+}
+
+type ManagementLockProperties struct {
+	// CanNotDelete or ReadOnly.
+	Level  string                `json:"level,omitempty"`
+	Notes  string                `json:"notes,omitempty"`
+	Owners []ManagementLockOwner `json:"owners,omitempty"`
+}
+
+type ManagementLockOwner struct {
+	ApplicationId string `json:"applicationId,omitempty"`
+}
+
+// LockedResourceId returns the ID of the subscription, resource group or resource the lock is applied to.
+func (s ManagementLock) LockedResourceId() string {
+	if index := strings.LastIndex(strings.ToLower(s.Id), lockIdSeparator); index >= 0 {
+		return s.Id[:index]
+	}
+	return ""
+}
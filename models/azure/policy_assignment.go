@@ -0,0 +1,48 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/policy/policy-assignments/list?view=rest-policy-2023-04-01
+type PolicyAssignment struct {
+	Entity
+
+	Identity   ManagedIdentity            `json:"identity,omitempty"`
+	Location   string                     `json:"location,omitempty"`
+	Name       string                     `json:"name,omitempty"`
+	Properties PolicyAssignmentProperties `json:"properties,omitempty"`
+	Type       string                     `json:"type,omitempty"`
+}
+
+type PolicyAssignmentProperties struct {
+	Description string `json:"description,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Default or DoNotEnforce. Remediation does not run for assignments that are not enforced.
+	EnforcementMode    string                          `json:"enforcementMode,omitempty"`
+	NotScopes          []string                        `json:"notScopes,omitempty"`
+	Parameters         map[string]PolicyParameterValue `json:"parameters,omitempty"`
+	PolicyDefinitionId string                          `json:"policyDefinitionId,omitempty"`
+	Scope              string                          `json:"scope,omitempty"`
+}
+
+// IsPolicySet reports whether the assignment assigns a policy set definition (initiative) rather than a single policy.
+func (s PolicyAssignment) IsPolicySet() bool {
+	return strings.Contains(strings.ToLower(s.Properties.PolicyDefinitionId), "/providers/microsoft.authorization/policysetdefinitions/")
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// Effects that make Azure Policy deploy or modify resources with the assignment's managed identity.
+const (
+	PolicyEffectDeployIfNotExists = "deployifnotexists"
+	PolicyEffectModify            = "modify"
+)
+
+var policyParameterExpression = regexp.MustCompile(`^\[parameters\('([^']+)'\)\]$`)
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/policy/policy-definitions/list?view=rest-policy-2023-04-01
+type PolicyDefinition struct {
+	Entity
+
+	Name       string                     `json:"name,omitempty"`
+	Properties PolicyDefinitionProperties `json:"properties,omitempty"`
+	Type       string                     `json:"type,omitempty"`
+}
+
+type PolicyDefinitionProperties struct {
+	Description string                               `json:"description,omitempty"`
+	DisplayName string                               `json:"displayName,omitempty"`
+	Mode        string                               `json:"mode,omitempty"`
+	Parameters  map[string]PolicyParameterDefinition `json:"parameters,omitempty"`
+	PolicyRule  PolicyRule                           `json:"policyRule,omitempty"`
+
+	// Builtin, Custom or Static. Only Custom definitions can be edited.
+	PolicyType string `json:"policyType,omitempty"`
+}
+
+type PolicyParameterDefinition struct {
+	AllowedValues []any  `json:"allowedValues,omitempty"`
+	DefaultValue  any    `json:"defaultValue,omitempty"`
+	Type          string `json:"type,omitempty"`
+}
+
+type PolicyParameterValue struct {
+	Value any `json:"value,omitempty"`
+}
+
+// Only the parts of the rule needed to tell what the policy does are mapped; the condition is omitted.
+type PolicyRule struct {
+	Then PolicyRuleThen `json:"then,omitempty"`
+}
+
+type PolicyRuleThen struct {
+	Details PolicyRuleDetails `json:"details,omitempty"`
+	Effect  string            `json:"effect,omitempty"`
+}
+
+type PolicyRuleDetails struct {
+	// The roles the assignment's managed identity needs to remediate DeployIfNotExists and Modify policies.
+	RoleDefinitionIds []string `json:"roleDefinitionIds,omitempty"`
+}
+
+// UnmarshalJSON ignores details that are not an object, such as the field and value pairs of the Append effect.
+func (s *PolicyRuleDetails) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) == 0 || trimmed[0] != '{' {
+		return nil
+	}
+
+	type policyRuleDetails PolicyRuleDetails
+	return json.Unmarshal(data, (*policyRuleDetails)(s))
+}
+
+// Effect returns the effect of the definition in lowercase, resolving a parameterized effect from the provided
+// parameter values or, failing that, the parameter's default value.
+func (s PolicyDefinition) Effect(parameters map[string]PolicyParameterValue) string {
+	return strings.ToLower(resolvePolicyParameter(s.Properties.PolicyRule.Then.Effect, parameters, s.Properties.Parameters))
+}
+
+func resolvePolicyParameter(value string, parameters map[string]PolicyParameterValue, definitions map[string]PolicyParameterDefinition) string {
+	if match := policyParameterExpression.FindStringSubmatch(value); match == nil {
+		return value
+	} else if parameter, ok := parameters[match[1]]; ok {
+		if resolved, ok := parameter.Value.(string); ok {
+			return resolved
+		}
+	} else if definition, ok := definitions[match[1]]; ok {
+		if resolved, ok := definition.DefaultValue.(string); ok {
+			return resolved
+		}
+	}
+	return value
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPolicyDefinition_Effect(t *testing.T) {
+	payload := []byte(`{
+		"id":"/providers/Microsoft.Management/managementGroups/mg-1/providers/Microsoft.Authorization/policyDefinitions/deploy-agent",
+		"properties":{
+			"parameters":{
+				"effect":{"type":"String","defaultValue":"AuditIfNotExists","allowedValues":["AuditIfNotExists","DeployIfNotExists"]}
+			},
+			"policyRule":{
+				"if":{"field":"type","equals":"Microsoft.Compute/virtualMachines"},
+				"then":{
+					"effect":"[parameters('effect')]",
+					"details":{"roleDefinitionIds":["/providers/Microsoft.Authorization/roleDefinitions/b24988ac-6180-42a0-ab88-20f7382dd24c"]}
+				}
+			},
+			"policyType":"Custom"
+		}
+	}`)
+
+	var definition PolicyDefinition
+	if err := json.Unmarshal(payload, &definition); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+
+	if effect := definition.Effect(nil); effect != "auditifnotexists" {
+		t.Errorf("expected default effect auditifnotexists, got %q", effect)
+	}
+
+	parameters := map[string]PolicyParameterValue{"effect": {Value: "DeployIfNotExists"}}
+	if effect := definition.Effect(parameters); effect != PolicyEffectDeployIfNotExists {
+		t.Errorf("expected assigned effect %q, got %q", PolicyEffectDeployIfNotExists, effect)
+	}
+
+	if roles := definition.Properties.PolicyRule.Then.Details.RoleDefinitionIds; len(roles) != 1 {
+		t.Errorf("expected 1 role definition id, got %d", len(roles))
+	}
+}
+
+func TestPolicyDefinition_AppendDetails(t *testing.T) {
+	payload := []byte(`{"properties":{"policyRule":{"then":{"effect":"append","details":[{"field":"a","value":1}]}}}}`)
+
+	var definition PolicyDefinition
+	if err := json.Unmarshal(payload, &definition); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+
+	if effect := definition.Effect(nil); effect != "append" {
+		t.Errorf("expected effect append, got %q", effect)
+	} else if roles := definition.Properties.PolicyRule.Then.Details.RoleDefinitionIds; len(roles) != 0 {
+		t.Errorf("expected no remediation roles, got %v", roles)
+	}
+}
+
+func TestPolicySetDefinition_MemberParameters(t *testing.T) {
+	setDefinition := PolicySetDefinition{
+		Properties: PolicySetDefinitionProperties{
+			Parameters: map[string]PolicyParameterDefinition{
+				"effect":   {DefaultValue: "Audit"},
+				"location": {DefaultValue: "westus"},
+			},
+		},
+	}
+	member := PolicyDefinitionReference{
+		Parameters: map[string]PolicyParameterValue{
+			"effect":   {Value: "[parameters('effect')]"},
+			"location": {Value: "[parameters('location')]"},
+			"literal":  {Value: "Modify"},
+			"count":    {Value: float64(3)},
+		},
+	}
+	assigned := map[string]PolicyParameterValue{"effect": {Value: "Modify"}}
+
+	resolved := setDefinition.MemberParameters(member, assigned)
+	if resolved["effect"].Value != "Modify" {
+		t.Errorf("expected assigned value Modify, got %v", resolved["effect"].Value)
+	} else if resolved["location"].Value != "westus" {
+		t.Errorf("expected default value westus, got %v", resolved["location"].Value)
+	} else if resolved["literal"].Value != "Modify" {
+		t.Errorf("expected literal value Modify, got %v", resolved["literal"].Value)
+	} else if resolved["count"].Value != float64(3) {
+		t.Errorf("expected non-string value to be passed through, got %v", resolved["count"].Value)
+	} else if member.Parameters["effect"].Value != "[parameters('effect')]" {
+		t.Errorf("expected member parameters not to be mutated")
+	}
+}
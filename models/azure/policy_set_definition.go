@@ -0,0 +1,55 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/policy/policy-set-definitions/get?view=rest-policy-2023-04-01
+type PolicySetDefinition struct {
+	Entity
+
+	Name       string                        `json:"name,omitempty"`
+	Properties PolicySetDefinitionProperties `json:"properties,omitempty"`
+	Type       string                        `json:"type,omitempty"`
+}
+
+type PolicySetDefinitionProperties struct {
+	Description       string                               `json:"description,omitempty"`
+	DisplayName       string                               `json:"displayName,omitempty"`
+	Parameters        map[string]PolicyParameterDefinition `json:"parameters,omitempty"`
+	PolicyDefinitions []PolicyDefinitionReference          `json:"policyDefinitions,omitempty"`
+	PolicyType        string                               `json:"policyType,omitempty"`
+}
+
+type PolicyDefinitionReference struct {
+	Parameters                  map[string]PolicyParameterValue `json:"parameters,omitempty"`
+	PolicyDefinitionId          string                          `json:"policyDefinitionId,omitempty"`
+	PolicyDefinitionReferenceId string                          `json:"policyDefinitionReferenceId,omitempty"`
+}
+
+// MemberParameters returns the parameter values passed to a member definition of the set, with references to the
+// set's own parameters resolved from the provided values or the set's defaults.
+func (s PolicySetDefinition) MemberParameters(member PolicyDefinitionReference, parameters map[string]PolicyParameterValue) map[string]PolicyParameterValue {
+	resolved := make(map[string]PolicyParameterValue, len(member.Parameters))
+	for name, parameter := range member.Parameters {
+		if value, ok := parameter.Value.(string); ok {
+			resolved[name] = PolicyParameterValue{Value: resolvePolicyParameter(value, parameters, s.Properties.Parameters)}
+		} else {
+			resolved[name] = parameter
+		}
+	}
+	return resolved
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+// An Azure Lighthouse registration assignment, which projects a registration definition onto a subscription or resource
+// group.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/managedservices/registration-assignments/list?view=rest-managedservices-2022-10-01
+type RegistrationAssignment struct {
+	Entity
+
+	Name       string                           `json:"name,omitempty"`
+	Properties RegistrationAssignmentProperties `json:"properties,omitempty"`
+	Type       string                           `json:"type,omitempty"`
+}
+
+type RegistrationAssignmentProperties struct {
+	// The current provisioning state of the registration assignment.
+	ProvisioningState string `json:"provisioningState,omitempty"`
+
+	// The registration definition, only present when requested with $expandRegistrationDefinition.
+	RegistrationDefinition *RegistrationDefinition `json:"registrationDefinition,omitempty"`
+
+	// The fully qualified path of the registration definition.
+	RegistrationDefinitionId string `json:"registrationDefinitionId,omitempty"`
+}
+
+// Scope returns the subscription or resource group the assignment delegates.
+func (s RegistrationAssignment) Scope() string {
+	if i := strings.Index(strings.ToLower(s.Id), "/providers/microsoft.managedservices/"); i >= 0 {
+		return s.Id[:i]
+	} else {
+		return ""
+	}
+}
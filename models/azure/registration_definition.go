@@ -0,0 +1,60 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// An Azure Lighthouse registration definition, which describes the access a managing tenant is offered.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/managedservices/registration-definitions/list?view=rest-managedservices-2022-10-01
+type RegistrationDefinition struct {
+	Entity
+
+	Name       string                           `json:"name,omitempty"`
+	Plan       Plan                             `json:"plan,omitempty"`
+	Properties RegistrationDefinitionProperties `json:"properties,omitempty"`
+	Type       string                           `json:"type,omitempty"`
+}
+
+type RegistrationDefinitionProperties struct {
+	// The collection of authorization objects describing the access Azure Active Directory principals in the managedBy
+	// tenant will receive on the delegated resource in the managed tenant.
+	Authorizations []LighthouseAuthorization `json:"authorizations,omitempty"`
+
+	// The description of the registration definition.
+	Description string `json:"description,omitempty"`
+
+	// The collection of authorization objects describing the eligible access principals in the managedBy tenant may
+	// activate on the delegated resource in the managed tenant.
+	EligibleAuthorizations []LighthouseEligibleAuthorization `json:"eligibleAuthorizations,omitempty"`
+
+	// The identifier of the managedBy tenant.
+	ManagedByTenantId string `json:"managedByTenantId,omitempty"`
+
+	// The name of the managedBy tenant.
+	ManagedByTenantName string `json:"managedByTenantName,omitempty"`
+
+	// The identifier of the managed tenant.
+	ManageeTenantId string `json:"manageeTenantId,omitempty"`
+
+	// The name of the managed tenant.
+	ManageeTenantName string `json:"manageeTenantName,omitempty"`
+
+	// The current provisioning state of the registration definition.
+	ProvisioningState string `json:"provisioningState,omitempty"`
+
+	// The name of the registration definition.
+	RegistrationDefinitionName string `json:"registrationDefinitionName,omitempty"`
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import (
+	"strings"
+
+	"github.com/bloodhoundad/azurehound/v2/enums"
+)
+
+// A generic Azure Resource Manager resource of any type.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/resources/resources/list?view=rest-resources-2021-04-01#genericresourceexpanded
+type Resource struct {
+	Entity
+
+	Identity  ManagedIdentity   `json:"identity,omitempty"`
+	Kind      string            `json:"kind,omitempty"`
+	Location  string            `json:"location,omitempty"`
+	ManagedBy string            `json:"managedBy,omitempty"`
+	Name      string            `json:"name,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	Type      string            `json:"type,omitempty"`
+}
+
+// HasManagedIdentity reports whether a system or user assigned managed identity is attached to the resource.
+func (s Resource) HasManagedIdentity() bool {
+	return s.Identity.Type != "" && s.Identity.Type != enums.IdentityNone
+}
+
+func (s Resource) ResourceGroupName() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 4 {
+		return parts[4]
+	} else {
+		return ""
+	}
+}
+
+func (s Resource) ResourceGroupId() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 5 {
+		return strings.Join(parts[:5], "/")
+	} else {
+		return ""
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "encoding/json"
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/azureresourcegraph/resourcegraph/resources/resources
+type ResourceGraphQueryRequest struct {
+	// Azure subscriptions against which to execute the query.
+	Subscriptions []string `json:"subscriptions,omitempty"`
+
+	// The resources query.
+	Query string `json:"query"`
+
+	// The query evaluation options.
+	Options ResourceGraphQueryOptions `json:"options,omitempty"`
+}
+
+type ResourceGraphQueryOptions struct {
+	// Defines in which format query result returned.
+	ResultFormat string `json:"resultFormat,omitempty"`
+
+	// Continuation token for pagination, capturing the next page size and offset, as well as the context of the query.
+	SkipToken string `json:"$skipToken,omitempty"`
+
+	// The maximum number of rows that the query should return. Overrides the page size when $skipToken property is present.
+	Top int `json:"$top,omitempty"`
+}
+
+type ResourceGraphQueryResponse struct {
+	// Number of records returned in the current response. In the case of paging, this is the number of records in the
+	// current page.
+	Count int64 `json:"count"`
+
+	// Query output in JSON format.
+	Data []json.RawMessage `json:"data"`
+
+	// Indicates whether the query results are truncated.
+	ResultTruncated string `json:"resultTruncated,omitempty"`
+
+	// When present, the value can be passed to a subsequent query call (together with the same query and scopes used in
+	// the current request) to retrieve the next page of data.
+	SkipToken string `json:"$skipToken,omitempty"`
+
+	// Number of total records matching the query.
+	TotalRecords int64 `json:"totalRecords"`
+}
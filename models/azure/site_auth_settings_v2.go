@@ -0,0 +1,100 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// The App Service Authentication / Authorization ("Easy Auth") configuration of a site. Client secrets are never
+// returned; only the name of the app setting holding them is.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/appservice/web-apps/get-auth-settings-v2?view=rest-appservice-2022-03-01
+type SiteAuthSettingsV2 struct {
+	Entity
+
+	Name       string                       `json:"name,omitempty"`
+	Properties SiteAuthSettingsV2Properties `json:"properties,omitempty"`
+	Type       string                       `json:"type,omitempty"`
+}
+
+type SiteAuthSettingsV2Properties struct {
+	GlobalValidation  SiteAuthGlobalValidation  `json:"globalValidation,omitempty"`
+	IdentityProviders SiteAuthIdentityProviders `json:"identityProviders,omitempty"`
+	Platform          SiteAuthPlatform          `json:"platform,omitempty"`
+}
+
+type SiteAuthPlatform struct {
+	// Whether the Authentication / Authorization feature is enabled for the site.
+	Enabled        bool   `json:"enabled,omitempty"`
+	RuntimeVersion string `json:"runtimeVersion,omitempty"`
+}
+
+type SiteAuthGlobalValidation struct {
+	// Paths for which unauthenticated flow is not redirected to the login page.
+	ExcludedPaths      []string `json:"excludedPaths,omitempty"`
+	RedirectToProvider string   `json:"redirectToProvider,omitempty"`
+
+	// Whether authentication is required; when false unauthenticated requests reach the app.
+	RequireAuthentication bool `json:"requireAuthentication,omitempty"`
+
+	// The action to take when an unauthenticated client attempts to access the app, e.g. AllowAnonymous.
+	UnauthenticatedClientAction string `json:"unauthenticatedClientAction,omitempty"`
+}
+
+type SiteAuthIdentityProviders struct {
+	AzureActiveDirectory         *SiteAuthAzureActiveDirectory       `json:"azureActiveDirectory,omitempty"`
+	Apple                        *SiteAuthIdentityProvider           `json:"apple,omitempty"`
+	AzureStaticWebApps           *SiteAuthIdentityProvider           `json:"azureStaticWebApps,omitempty"`
+	CustomOpenIdConnectProviders map[string]SiteAuthIdentityProvider `json:"customOpenIdConnectProviders,omitempty"`
+	Facebook                     *SiteAuthIdentityProvider           `json:"facebook,omitempty"`
+	GitHub                       *SiteAuthIdentityProvider           `json:"gitHub,omitempty"`
+	Google                       *SiteAuthIdentityProvider           `json:"google,omitempty"`
+	Twitter                      *SiteAuthIdentityProvider           `json:"twitter,omitempty"`
+}
+
+type SiteAuthIdentityProvider struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+type SiteAuthAzureActiveDirectory struct {
+	Enabled           bool                                     `json:"enabled,omitempty"`
+	IsAutoProvisioned bool                                     `json:"isAutoProvisioned,omitempty"`
+	Registration      SiteAuthAzureActiveDirectoryRegistration `json:"registration,omitempty"`
+	Validation        SiteAuthAzureActiveDirectoryValidation   `json:"validation,omitempty"`
+}
+
+type SiteAuthAzureActiveDirectoryRegistration struct {
+	// The client ID of the Entra ID application used for authentication.
+	ClientId string `json:"clientId,omitempty"`
+
+	// The app setting name that contains the client secret of the relying party application.
+	ClientSecretSettingName string `json:"clientSecretSettingName,omitempty"`
+	OpenIdIssuer            string `json:"openIdIssuer,omitempty"`
+}
+
+type SiteAuthAzureActiveDirectoryValidation struct {
+	AllowedAudiences           []string                           `json:"allowedAudiences,omitempty"`
+	DefaultAuthorizationPolicy SiteAuthDefaultAuthorizationPolicy `json:"defaultAuthorizationPolicy,omitempty"`
+}
+
+type SiteAuthDefaultAuthorizationPolicy struct {
+	// The client IDs of applications allowed to call the site.
+	AllowedApplications []string                  `json:"allowedApplications,omitempty"`
+	AllowedPrincipals   SiteAuthAllowedPrincipals `json:"allowedPrincipals,omitempty"`
+}
+
+type SiteAuthAllowedPrincipals struct {
+	Groups     []string `json:"groups,omitempty"`
+	Identities []string `json:"identities,omitempty"`
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// The deployment source linked to a site.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/appservice/web-apps/get-source-control?view=rest-appservice-2022-03-01
+type SiteSourceControl struct {
+	Entity
+
+	Name       string                      `json:"name,omitempty"`
+	Properties SiteSourceControlProperties `json:"properties,omitempty"`
+	Type       string                      `json:"type,omitempty"`
+}
+
+type SiteSourceControlProperties struct {
+	Branch                    string `json:"branch,omitempty"`
+	DeploymentRollbackEnabled bool   `json:"deploymentRollbackEnabled,omitempty"`
+
+	// Whether the site deploys from GitHub Actions rather than the App Service build service.
+	IsGitHubAction bool `json:"isGitHubAction,omitempty"`
+
+	// Whether the repository is synced manually rather than on push.
+	IsManualIntegration bool   `json:"isManualIntegration,omitempty"`
+	IsMercurial         bool   `json:"isMercurial,omitempty"`
+	RepoUrl             string `json:"repoUrl,omitempty"`
+}
+
+// IsConfigured reports whether the site is linked to a deployment source at all.
+func (s SiteSourceControl) IsConfigured() bool {
+	return s.Properties.RepoUrl != "" || s.Properties.IsGitHubAction
+}
@@ -50,3 +50,9 @@ func (s StorageAccount) ResourceGroupId() string {
 		return ""
 	}
 }
+
+// SharedKeyAccessAllowed reports whether requests may be authorized with the account access key. The service treats
+// an unset allowSharedKeyAccess as true.
+func (s StorageAccount) SharedKeyAccessAllowed() bool {
+	return s.Properties.AllowSharedKeyAccess == nil || *s.Properties.AllowSharedKeyAccess
+}
@@ -23,7 +23,7 @@ type StorageAccountProperties struct {
 	AccessTier                            enums.StorageAccountAccessTier        `json:"accessTier,omitempty"`
 	AllowBlobPublicAccess                 bool                                  `json:"allowBlobPublicAccess,omitempty"`
 	AllowCrossTenantReplication           bool                                  `json:"allowCrossTenantReplication,omitempty"`
-	AllowSharedKeyAccess                  bool                                  `json:"allowSharedKeyAccess,omitempty"`
+	AllowSharedKeyAccess                  *bool                                 `json:"allowSharedKeyAccess,omitempty"`
 	AllowedCopyScope                      enums.AllowedCopyScope                `json:"allowedCopyScope,omitempty"`
 	AzureFilesIdentityBasedAuthentication AzureFilesIdentityBasedAuthentication `json:"azureFilesIdentityBasedAuthentication,omitempty"`
 	BlobRestoreStatus                     BlobRestoreStatus                     `json:"blobRestoreStatus,omitempty"`
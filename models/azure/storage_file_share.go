@@ -0,0 +1,47 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "github.com/bloodhoundad/azurehound/v2/enums"
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/storagerp/file-shares/list?view=rest-storagerp-2023-01-01#fileshareitem
+type StorageFileShare struct {
+	Entity
+
+	Etag       string                     `json:"etag,omitempty"`
+	Name       string                     `json:"name,omitempty"`
+	Properties StorageFileShareProperties `json:"properties,omitempty"`
+	Type       string                     `json:"type,omitempty"`
+}
+
+type StorageFileShareProperties struct {
+	AccessTier             string                    `json:"accessTier,omitempty"`
+	Deleted                bool                      `json:"deleted,omitempty"`
+	DeletedTime            string                    `json:"deletedTime,omitempty"`
+	EnabledProtocols       string                    `json:"enabledProtocols,omitempty"`
+	LastModifiedTime       string                    `json:"lastModifiedTime,omitempty"`
+	LeaseDuration          enums.LeaseDuration       `json:"leaseDuration,omitempty"`
+	LeaseState             enums.LeaseState          `json:"leaseState,omitempty"`
+	LeaseStatus            enums.LeaseStatus         `json:"leaseStatus,omitempty"`
+	Metadata               interface{}               `json:"metadata,omitempty"`
+	RemainingRetentionDays int                       `json:"remainingRetentionDays,omitempty"`
+	RootSquash             string                    `json:"rootSquash,omitempty"`
+	ShareQuota             int                       `json:"shareQuota,omitempty"`
+	SignedIdentifiers      []StorageSignedIdentifier `json:"signedIdentifiers,omitempty"`
+	Version                string                    `json:"version,omitempty"`
+}
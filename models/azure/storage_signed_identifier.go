@@ -0,0 +1,30 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// A stored access policy that shared access signatures may reference
+type StorageSignedIdentifier struct {
+	Id           string              `json:"id,omitempty"`
+	AccessPolicy StorageAccessPolicy `json:"accessPolicy,omitempty"`
+}
+
+type StorageAccessPolicy struct {
+	ExpiryTime string `json:"expiryTime,omitempty"`
+	Permission string `json:"permission,omitempty"`
+	StartTime  string `json:"startTime,omitempty"`
+}
@@ -23,12 +23,13 @@ import "strings"
 type WebApp struct {
 	Entity
 
-	Identity ManagedIdentity   `json:"identity,omitempty"`
-	Kind     string            `json:"kind,omitempty"`
-	Location string            `json:"location,omitempty"`
-	Name     string            `json:"name,omitempty"`
-	Tags     map[string]string `json:"tags,omitempty"`
-	Type     string            `json:"type,omitempty"`
+	Identity   ManagedIdentity   `json:"identity,omitempty"`
+	Kind       string            `json:"kind,omitempty"`
+	Location   string            `json:"location,omitempty"`
+	Name       string            `json:"name,omitempty"`
+	Properties WebAppProperties  `json:"properties,omitempty"`
+	Tags       map[string]string `json:"tags,omitempty"`
+	Type       string            `json:"type,omitempty"`
 }
 
 func (s WebApp) ResourceGroupName() string {
@@ -0,0 +1,45 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+// The site properties returned when listing web apps that container registry pullers are found by. Only the container
+// image settings are mapped so that they are the only addition to the AZWebApp output.
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/appservice/web-apps/list#site
+type WebAppProperties struct {
+	SiteConfig WebAppSiteConfig `json:"siteConfig,omitempty"`
+}
+
+// The container image settings of a site, see SiteConfig for the full configuration returned for a single site.
+type WebAppSiteConfig struct {
+	AcrUseManagedIdentityCreds bool   `json:"acrUseManagedIdentityCreds,omitempty"`
+	AcrUserManagedIdentityID   string `json:"acrUserManagedIdentityID,omitempty"`
+	LinuxFxVersion             string `json:"linuxFxVersion,omitempty"`
+	WindowsFxVersion           string `json:"windowsFxVersion,omitempty"`
+}
+
+// ContainerImage returns the image a custom container site runs, e.g. myregistry.azurecr.io/app:latest, if any.
+func (s WebAppSiteConfig) ContainerImage() string {
+	for _, fxVersion := range []string{s.LinuxFxVersion, s.WindowsFxVersion} {
+		if kind, image, ok := strings.Cut(fxVersion, "|"); ok && strings.EqualFold(kind, "DOCKER") {
+			return image
+		}
+	}
+	return ""
+}
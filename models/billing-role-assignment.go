@@ -0,0 +1,47 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// BillingRoleAssignment grants an Entra principal a billing role on a billing account, billing profile or invoice
+// section. Billing roles are managed outside of Azure RBAC and allow, among other things, creating new subscriptions.
+type BillingRoleAssignment struct {
+	azure.BillingRoleAssignment
+	BillingScopeId string `json:"billingScopeId"`
+	TenantId       string `json:"tenantId"`
+}
+
+func (s BillingRoleAssignment) MarshalJSON() ([]byte, error) {
+	type Alias BillingRoleAssignment
+	a := Alias(s)
+	a.Id = strings.ToUpper(a.Id)
+	a.BillingScopeId = strings.ToUpper(a.BillingScopeId)
+	a.TenantId = strings.ToUpper(a.TenantId)
+	a.Properties.CreatedByPrincipalId = strings.ToUpper(a.Properties.CreatedByPrincipalId)
+	a.Properties.CreatedByPrincipalTenantId = strings.ToUpper(a.Properties.CreatedByPrincipalTenantId)
+	a.Properties.PrincipalId = strings.ToUpper(a.Properties.PrincipalId)
+	a.Properties.PrincipalTenantId = strings.ToUpper(a.Properties.PrincipalTenantId)
+	a.Properties.Scope = strings.ToUpper(a.Properties.Scope)
+	return json.Marshal(a)
+}
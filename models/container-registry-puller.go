@@ -0,0 +1,49 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/bloodhoundad/azurehound/v2/enums"
+)
+
+// ContainerRegistryPuller links a container registry to a web app or managed cluster that pulls images from it.
+type ContainerRegistryPuller struct {
+	ContainerRegistryId string     `json:"containerRegistryId"`
+	LoginServer         string     `json:"loginServer"`
+	PullerId            string     `json:"pullerId"`
+	PullerKind          enums.Kind `json:"pullerKind"`
+	Image               string     `json:"image,omitempty"`
+
+	// The principal the puller authenticates to the registry as. Empty when a web app pulls with registry credentials
+	// (the admin user or a token) stored in its app settings.
+	PrincipalId string `json:"principalId,omitempty"`
+	TenantId    string `json:"tenantId"`
+}
+
+func (s ContainerRegistryPuller) MarshalJSON() ([]byte, error) {
+	type Alias ContainerRegistryPuller
+	a := Alias(s)
+	a.ContainerRegistryId = strings.ToUpper(a.ContainerRegistryId)
+	a.PullerId = strings.ToUpper(a.PullerId)
+	a.PrincipalId = strings.ToUpper(a.PrincipalId)
+	a.TenantId = strings.ToUpper(a.TenantId)
+	return json.Marshal(a)
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/bloodhoundad/azurehound/v2/enums"
+)
+
+// DeltaMarker is emitted once an incremental collection of a kind has completed.
+//
+// When Incremental is true the output only holds the objects of that kind changed or deleted since the previous
+// collection, and changed objects may only carry the properties that changed. Otherwise no previous collection was
+// recorded for the tenant and every object of that kind was listed.
+type DeltaMarker struct {
+	Kind        enums.Kind `json:"kind"`
+	Incremental bool       `json:"incremental"`
+	TenantId    string     `json:"tenantId"`
+}
+
+func (s DeltaMarker) MarshalJSON() ([]byte, error) {
+	type Alias DeltaMarker
+	a := Alias(s)
+	a.TenantId = strings.ToUpper(a.TenantId)
+	return json.Marshal(a)
+}
@@ -26,6 +26,7 @@ import (
 
 type FunctionApp struct {
 	azure.FunctionApp
+	SiteSettings
 	SubscriptionId    string `json:"subscriptionId"`
 	ResourceGroupId   string `json:"resourceGroupId"`
 	ResourceGroupName string `json:"resourceGroupName"`
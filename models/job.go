@@ -59,4 +59,7 @@ type ClientJob struct {
 	EndTime          time.Time `json:"end_time"`
 	Status           JobStatus `json:"status"`
 	StatusMessage    string    `json:"status_message"`
+
+	// If true then only the directory objects changed since the previous incremental collection are collected
+	IncrementalCollection bool `json:"incremental_collection"`
 }
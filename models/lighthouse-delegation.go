@@ -0,0 +1,66 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// LighthouseDelegation maps the principals of a managing tenant to the roles they hold on a subscription or resource
+// group delegated through Azure Lighthouse. These roles never appear as role assignments in the managed tenant.
+type LighthouseDelegation struct {
+	RegistrationAssignmentId string                                  `json:"registrationAssignmentId"`
+	RegistrationDefinitionId string                                  `json:"registrationDefinitionId"`
+	Scope                    string                                  `json:"scope"`
+	ManagedByTenantId        string                                  `json:"managedByTenantId"`
+	ManagedByTenantName      string                                  `json:"managedByTenantName"`
+	ManagedByTenants         []azure.ManagedByTenant                 `json:"managedByTenants"`
+	Authorizations           []azure.LighthouseAuthorization         `json:"authorizations"`
+	EligibleAuthorizations   []azure.LighthouseEligibleAuthorization `json:"eligibleAuthorizations"`
+	SubscriptionId           string                                  `json:"subscriptionId"`
+	TenantId                 string                                  `json:"tenantId"`
+}
+
+func (s LighthouseDelegation) MarshalJSON() ([]byte, error) {
+	type Alias LighthouseDelegation
+	a := Alias(s)
+	a.RegistrationAssignmentId = strings.ToUpper(a.RegistrationAssignmentId)
+	a.RegistrationDefinitionId = strings.ToUpper(a.RegistrationDefinitionId)
+	a.Scope = strings.ToUpper(a.Scope)
+	a.ManagedByTenantId = strings.ToUpper(a.ManagedByTenantId)
+	a.SubscriptionId = strings.ToUpper(a.SubscriptionId)
+	a.TenantId = strings.ToUpper(a.TenantId)
+
+	a.Authorizations = make([]azure.LighthouseAuthorization, len(s.Authorizations))
+	for i, authorization := range s.Authorizations {
+		authorization.PrincipalId = strings.ToUpper(authorization.PrincipalId)
+		authorization.RoleDefinitionId = strings.ToUpper(authorization.RoleDefinitionId)
+		a.Authorizations[i] = authorization
+	}
+
+	a.EligibleAuthorizations = make([]azure.LighthouseEligibleAuthorization, len(s.EligibleAuthorizations))
+	for i, authorization := range s.EligibleAuthorizations {
+		authorization.PrincipalId = strings.ToUpper(authorization.PrincipalId)
+		authorization.RoleDefinitionId = strings.ToUpper(authorization.RoleDefinitionId)
+		a.EligibleAuthorizations[i] = authorization
+	}
+	return json.Marshal(a)
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// LogicAppApiConnections links a workflow to the API connections it uses. Anyone able to edit the workflow can act
+// with the access consented to those connections.
+type LogicAppApiConnections struct {
+	Connections           []azure.LogicAppConnectionReference `json:"connections"`
+	HasHttpRequestTrigger bool                                `json:"hasHttpRequestTrigger"`
+	LogicAppId            string                              `json:"logicAppId"`
+	TenantId              string                              `json:"tenantId"`
+}
+
+func (s LogicAppApiConnections) MarshalJSON() ([]byte, error) {
+	type Alias LogicAppApiConnections
+	a := Alias(s)
+	a.LogicAppId = strings.ToUpper(a.LogicAppId)
+	a.TenantId = strings.ToUpper(a.TenantId)
+	if a.Connections != nil {
+		connections := make([]azure.LogicAppConnectionReference, len(a.Connections))
+		for i, connection := range a.Connections {
+			connection.ConnectionId = strings.ToUpper(connection.ConnectionId)
+			connections[i] = connection
+		}
+		a.Connections = connections
+	}
+	return json.Marshal(a)
+}
@@ -26,9 +26,10 @@ import (
 
 type ManagedCluster struct {
 	azure.ManagedCluster
-	SubscriptionId  string `json:"subscriptionId"`
-	ResourceGroupId string `json:"resourceGroupId"`
-	TenantId        string `json:"tenantId"`
+	SubscriptionId   string `json:"subscriptionId"`
+	ResourceGroupId  string `json:"resourceGroupId"`
+	TenantId         string `json:"tenantId"`
+	AzureRBACEnabled bool   `json:"azureRBACEnabled"` // Whether Kubernetes authorization checks are delegated to Azure RBAC
 }
 
 func (s ManagedCluster) MarshalJSON() ([]byte, error) {
@@ -40,5 +41,23 @@ func (s ManagedCluster) MarshalJSON() ([]byte, error) {
 	a.TenantId = strings.ToUpper(a.TenantId)
 	a.Properties.NodeResourceGroup = strings.ToUpper(a.Properties.NodeResourceGroup)
 	a.Identity = UpperManagedIdentity(a.Identity)
+	if a.Properties.AADProfile != nil {
+		aadProfile := *a.Properties.AADProfile
+		aadProfile.TenantID = strings.ToUpper(aadProfile.TenantID)
+		aadProfile.AdminGroupObjectIDs = make([]string, len(a.Properties.AADProfile.AdminGroupObjectIDs))
+		for i, id := range a.Properties.AADProfile.AdminGroupObjectIDs {
+			aadProfile.AdminGroupObjectIDs[i] = strings.ToUpper(id)
+		}
+		a.Properties.AADProfile = &aadProfile
+	}
+	if a.Properties.IdentityProfile != nil {
+		identityProfile := make(map[string]azure.ManagedClusterUserAssignedIdentity, len(a.Properties.IdentityProfile))
+		for key, identity := range a.Properties.IdentityProfile {
+			identity.ObjectId = strings.ToUpper(identity.ObjectId)
+			identity.ResourceId = strings.ToUpper(identity.ResourceId)
+			identityProfile[key] = identity
+		}
+		a.Properties.IdentityProfile = identityProfile
+	}
 	return json.Marshal(a)
 }
@@ -595,6 +595,34 @@ func TestManagedClusterMarshalJSONUppercasesNodeResourceGroupAndIdentity(t *test
 	require.Equal(t, "principal-sys", mc.Identity.PrincipalId)
 }
 
+func TestManagedClusterMarshalJSONUppercasesSecurityProfileIds(t *testing.T) {
+	mc := models.ManagedCluster{}
+	mc.Properties.AADProfile = &azure.ManagedClusterAADProfile{
+		AdminGroupObjectIDs: []string{"group-1"},
+		EnableAzureRBAC:     true,
+		TenantID:            "tenant-1",
+	}
+	mc.Properties.IdentityProfile = map[string]azure.ManagedClusterUserAssignedIdentity{
+		"kubeletidentity": {ClientId: "client-1", ObjectId: "object-1", ResourceId: "/subscriptions/sub-1/uai-1"},
+	}
+	mc.AzureRBACEnabled = mc.ManagedCluster.AzureRBACEnabled()
+
+	out := marshalToMap(t, mc)
+
+	props := out["properties"].(map[string]any)
+	aadProfile := props["aadProfile"].(map[string]any)
+	require.Equal(t, []any{"GROUP-1"}, aadProfile["adminGroupObjectIDs"])
+	require.Equal(t, "TENANT-1", aadProfile["tenantID"])
+	require.Equal(t, true, aadProfile["enableAzureRBAC"])
+	require.Equal(t, true, out["azureRBACEnabled"])
+	kubelet := props["identityProfile"].(map[string]any)["kubeletidentity"].(map[string]any)
+	require.Equal(t, "OBJECT-1", kubelet["objectId"])
+	require.Equal(t, "/SUBSCRIPTIONS/SUB-1/UAI-1", kubelet["resourceId"])
+	// Source is unchanged.
+	require.Equal(t, "group-1", mc.Properties.AADProfile.AdminGroupObjectIDs[0])
+	require.Equal(t, "object-1", mc.Properties.IdentityProfile["kubeletidentity"].ObjectId)
+}
+
 func TestStorageAccountMarshalJSONUppercasesIdentifiersAndIdentity(t *testing.T) {
 	sa := models.StorageAccount{
 		SubscriptionId:    "sub-1",
@@ -734,3 +762,49 @@ func TestRoleManagementPolicyAssignmentMarshalJSONUppercasesApproverIds(t *testi
 	// Source is unchanged.
 	require.Contains(t, string(rmpa.Policy.Rules[0]), "group-1")
 }
+
+func TestAutomationVariableMarshalJSONUppercasesIdentifiersAndKeepsEncryptedFlag(t *testing.T) {
+	variable := models.AutomationVariable{
+		AutomationAccountId: "/subscriptions/sub-1/resourcegroups/rg-1/providers/microsoft.automation/automationaccounts/aa-1",
+		SubscriptionId:      "/subscriptions/sub-1",
+		ResourceGroupId:     "/subscriptions/sub-1/resourcegroups/rg-1",
+		TenantId:            "tenant-1",
+	}
+	variable.Id = "/subscriptions/sub-1/resourcegroups/rg-1/providers/microsoft.automation/automationaccounts/aa-1/variables/v-1"
+	variable.Name = "v-1"
+
+	out := marshalToMap(t, variable)
+
+	require.Equal(t, "/SUBSCRIPTIONS/SUB-1/RESOURCEGROUPS/RG-1/PROVIDERS/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/AA-1/VARIABLES/V-1", out["id"])
+	require.Equal(t, "/SUBSCRIPTIONS/SUB-1/RESOURCEGROUPS/RG-1/PROVIDERS/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/AA-1", out["automationAccountId"])
+	require.Equal(t, "/SUBSCRIPTIONS/SUB-1", out["subscriptionId"])
+	require.Equal(t, "TENANT-1", out["tenantId"])
+	require.Equal(t, "v-1", out["name"])
+	// An unencrypted variable must still report its encryption state.
+	properties := out["properties"].(map[string]any)
+	require.Equal(t, false, properties["isEncrypted"])
+	require.NotContains(t, properties, "value")
+}
+
+func TestKeyVaultKeyMarshalJSONUppercasesKidAndVaultId(t *testing.T) {
+	key := models.KeyVaultKey{
+		KeyVaultId: "/subscriptions/sub-1/resourcegroups/rg-1/providers/microsoft.keyvault/vaults/kv-1",
+		Name:       "signing-key",
+		TenantId:   "tenant-1",
+	}
+	key.Kid = "https://kv-1.vault.azure.net/keys/signing-key"
+	key.Attributes.Enabled = true
+	key.Attributes.Expires = 1767225600
+
+	out := marshalToMap(t, key)
+
+	require.Equal(t, "HTTPS://KV-1.VAULT.AZURE.NET/KEYS/SIGNING-KEY", out["kid"])
+	require.Equal(t, "/SUBSCRIPTIONS/SUB-1/RESOURCEGROUPS/RG-1/PROVIDERS/MICROSOFT.KEYVAULT/VAULTS/KV-1", out["keyVaultId"])
+	require.Equal(t, "TENANT-1", out["tenantId"])
+	require.Equal(t, "signing-key", out["name"])
+	attributes := out["attributes"].(map[string]any)
+	require.Equal(t, true, attributes["enabled"])
+	require.Equal(t, float64(1767225600), attributes["exp"])
+	// Source is unchanged.
+	require.Equal(t, "https://kv-1.vault.azure.net/keys/signing-key", key.Kid)
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// PolicyAssignment is a policy or policy set assignment at management group or subscription scope. Effects holds the
+// resolved effect of every policy the assignment applies, and RoleDefinitionIds the roles its managed identity needs
+// to remediate those that deploy or modify resources.
+type PolicyAssignment struct {
+	azure.PolicyAssignment
+	Effects           []string `json:"effects"`
+	RoleDefinitionIds []string `json:"roleDefinitionIds"`
+	TenantId          string   `json:"tenantId"`
+}
+
+func (s PolicyAssignment) MarshalJSON() ([]byte, error) {
+	type Alias PolicyAssignment
+	a := Alias(s)
+	a.Id = strings.ToUpper(a.Id)
+	a.TenantId = strings.ToUpper(a.TenantId)
+	a.Identity = UpperManagedIdentity(a.Identity)
+	a.Properties.PolicyDefinitionId = strings.ToUpper(a.Properties.PolicyDefinitionId)
+	a.Properties.Scope = strings.ToUpper(a.Properties.Scope)
+	a.Properties.NotScopes = upperStrings(s.Properties.NotScopes)
+	a.RoleDefinitionIds = upperStrings(s.RoleDefinitionIds)
+	return json.Marshal(a)
+}
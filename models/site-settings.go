@@ -0,0 +1,37 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// SiteSettings holds the deployment credential, authentication and deployment source settings of a web app or function
+// app, each fetched from a separate endpoint. A nil value means the setting could not be read.
+type SiteSettings struct {
+	// Whether basic authentication is allowed for the FTP publishing endpoint.
+	FtpBasicAuthEnabled *bool `json:"ftpBasicAuthEnabled,omitempty"`
+
+	// Whether basic authentication is allowed for the SCM (Kudu) publishing endpoint.
+	ScmBasicAuthEnabled *bool `json:"scmBasicAuthEnabled,omitempty"`
+
+	AuthSettings  *azure.SiteAuthSettingsV2 `json:"authSettings,omitempty"`
+	ScmType       enums.ScmType             `json:"scmType,omitempty"`
+	SourceControl *azure.SiteSourceControl  `json:"sourceControl,omitempty"`
+}
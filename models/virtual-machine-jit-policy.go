@@ -0,0 +1,48 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// VirtualMachineJitPolicy links a virtual machine to the just-in-time access policy that protects it, with the
+// management ports that can be opened on request and the source addresses they can be opened to.
+type VirtualMachineJitPolicy struct {
+	JitNetworkAccessPolicyId   string                           `json:"jitNetworkAccessPolicyId"`
+	JitNetworkAccessPolicyName string                           `json:"jitNetworkAccessPolicyName"`
+	Location                   string                           `json:"location"`
+	Ports                      []azure.JitNetworkAccessPortRule `json:"ports"`
+	PublicIpAddress            string                           `json:"publicIpAddress,omitempty"`
+	VirtualMachineId           string                           `json:"virtualMachineId"`
+	SubscriptionId             string                           `json:"subscriptionId"`
+	TenantId                   string                           `json:"tenantId"`
+}
+
+func (s VirtualMachineJitPolicy) MarshalJSON() ([]byte, error) {
+	type Alias VirtualMachineJitPolicy
+	a := Alias(s)
+	a.JitNetworkAccessPolicyId = strings.ToUpper(a.JitNetworkAccessPolicyId)
+	a.VirtualMachineId = strings.ToUpper(a.VirtualMachineId)
+	a.SubscriptionId = strings.ToUpper(a.SubscriptionId)
+	a.TenantId = strings.ToUpper(a.TenantId)
+	return json.Marshal(a)
+}
@@ -26,6 +26,7 @@ import (
 
 type WebApp struct {
 	azure.WebApp
+	SiteSettings
 	SubscriptionId    string `json:"subscriptionId"`
 	ResourceGroupId   string `json:"resourceGroupId"`
 	ResourceGroupName string `json:"resourceGroupName"`